@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AccountInformation model
+type AccountInformation struct {
+	// User ID
+	UserID int64 `json:"userId,omitempty"`
+
+	// User name
+	UserName string `json:"userName,omitempty"`
+
+	// User email
+	UserEmail string `json:"userEmail,omitempty"`
+
+	// Company name
+	CompanyName string `json:"companyName,omitempty"`
+
+	// Whether the user is a member of another user's team
+	IsTeamMember bool `json:"isTeamMember,omitempty"`
+
+	// Team leader of the team the user is a member of
+	TeamLeader string `json:"teamLeader,omitempty"`
+
+	// Formatted account credit balance
+	AccountBalance string `json:"accountBalance,omitempty"`
+
+	// Account credit balance
+	AccountBalanceRaw json.Number `json:"accountBalanceRaw,omitempty"`
+
+	// Currency of the account credit balance
+	AccountBalanceRawCurrency string `json:"accountBalanceRawCurrency,omitempty"`
+
+	// Maximum number of servers the account can have
+	ServerLimit int64 `json:"serverLimit,omitempty"`
+}
+
+func (c *Client) GetAccountInformation(ctx context.Context) (*AccountInformation, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += "account/accountInformation"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account information: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account information: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting account information: %w", newAPIError(res))
+	}
+
+	account := AccountInformation{}
+
+	if err = json.NewDecoder(res.Body).Decode(&account); err != nil {
+		return nil, fmt.Errorf("error decoding get account information response body: %w", err)
+	}
+
+	return &account, nil
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Script model
+type Script struct {
+	// Script ID
+	ID json.Number `json:"id,omitempty" mapstructure:"id"`
+
+	// Script name
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Script description
+	Description string `json:"description,omitempty" mapstructure:"description"`
+
+	// Script file name
+	Filename string `json:"filename,omitempty" mapstructure:"filename"`
+
+	// Script content
+	Content string `json:"content,omitempty" mapstructure:"content"`
+}
+
+// Scripts is a collection of Script
+type Scripts []Script
+
+// Create or update script model
+type ScriptRequestBody struct {
+	// Script name
+	Name string `json:"name"`
+
+	// Script file name
+	Filename string `json:"filename"`
+
+	// Script content
+	Content string `json:"content"`
+}
+
+func (c *Client) GetScripts(ctx context.Context) (Scripts, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += "account/scripts"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scripts: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scripts: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting scripts: %w", newAPIError(res))
+	}
+
+	scripts := Scripts{}
+
+	if err = json.NewDecoder(res.Body).Decode(&scripts); err != nil {
+		return nil, fmt.Errorf("error decoding get scripts response body: %w", err)
+	}
+
+	return scripts, nil
+}
+
+func (c *Client) GetScript(ctx context.Context, scriptID int64) (*Script, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("account/scripts/%d", scriptID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting script: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting script: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting script: %w", newAPIError(res))
+	}
+
+	script := Script{}
+
+	if err = json.NewDecoder(res.Body).Decode(&script); err != nil {
+		return nil, fmt.Errorf("error decoding get script response body: %w", err)
+	}
+
+	return &script, nil
+}
+
+func (c *Client) CreateScript(ctx context.Context, body ScriptRequestBody) (*Script, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += "account/scripts"
+
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating script: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating script: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error creating script: %w", newAPIError(res))
+	}
+
+	script := Script{}
+
+	if err = json.NewDecoder(res.Body).Decode(&script); err != nil {
+		return nil, fmt.Errorf("error decoding create script response body: %w", err)
+	}
+
+	return &script, nil
+}
+
+func (c *Client) UpdateScript(ctx context.Context, scriptID int64, body ScriptRequestBody) (*Script, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("account/scripts/%d", scriptID)
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("error updating script: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error updating script: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error updating script: %w", newAPIError(res))
+	}
+
+	script := Script{}
+
+	if err = json.NewDecoder(res.Body).Decode(&script); err != nil {
+		return nil, fmt.Errorf("error decoding update script response body: %w", err)
+	}
+
+	return &script, nil
+}
+
+func (c *Client) DeleteScript(ctx context.Context, scriptID int64) error {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return err
+	}
+
+	serverURL.Path += fmt.Sprintf("account/scripts/%d", scriptID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", serverURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting script: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error deleting script: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return fmt.Errorf("error deleting script: %w", newAPIError(res))
+	}
+
+	return nil
+}
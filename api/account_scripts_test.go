@@ -0,0 +1,375 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetScripts(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		wantResponse  api.Scripts
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting scripts: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/account/scripts",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id": 1,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get scripts response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/account/scripts", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+					{
+						"id":          1,
+						"name":        "bootstrap",
+						"description": "installs packages",
+						"filename":    "bootstrap.sh",
+						"content":     "apt-get update",
+					},
+				})
+			})),
+			ctx: context.Background(),
+			wantResponse: api.Scripts{
+				{
+					ID:          json.Number("1"),
+					Name:        "bootstrap",
+					Description: "installs packages",
+					Filename:    "bootstrap.sh",
+					Content:     "apt-get update",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			scripts, err := client.GetScripts(test.ctx)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, scripts)
+		})
+	}
+}
+
+func TestGetScript(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		id            int64
+		wantResponse  *api.Script
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "script not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting script: %w", api.APIError{
+				ID:         1,
+				Message:    "script not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "GET",
+				Path:       "/account/scripts/0",
+				Body:       "{\"id\":1,\"message\":\"script not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"name": true,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get script response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/account/scripts/1", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":       1,
+					"name":     "bootstrap",
+					"filename": "bootstrap.sh",
+					"content":  "apt-get update",
+				})
+			})),
+			ctx: context.Background(),
+			id:  1,
+			wantResponse: &api.Script{
+				ID:       json.Number("1"),
+				Name:     "bootstrap",
+				Filename: "bootstrap.sh",
+				Content:  "apt-get update",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			script, err := client.GetScript(test.ctx, test.id)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, script)
+		})
+	}
+}
+
+func TestCreateScript(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		req          api.ScriptRequestBody
+		wantResponse *api.Script
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error creating script: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "POST",
+				Path:       "/account/scripts",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/account/scripts", r.URL.Path)
+
+				body := api.ScriptRequestBody{}
+
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":       1,
+					"name":     body.Name,
+					"filename": body.Filename,
+					"content":  body.Content,
+				})
+			})),
+			ctx: context.Background(),
+			req: api.ScriptRequestBody{
+				Name:     "bootstrap",
+				Filename: "bootstrap.sh",
+				Content:  "apt-get update",
+			},
+			wantResponse: &api.Script{
+				ID:       json.Number("1"),
+				Name:     "bootstrap",
+				Filename: "bootstrap.sh",
+				Content:  "apt-get update",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			script, err := client.CreateScript(test.ctx, test.req)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, script)
+		})
+	}
+}
+
+func TestUpdateScript(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		id           int64
+		req          api.ScriptRequestBody
+		wantResponse *api.Script
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error updating script: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "PATCH",
+				Path:       "/account/scripts/0",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PATCH", r.Method)
+				assert.Equal(t, "/account/scripts/1", r.URL.Path)
+
+				body := api.ScriptRequestBody{}
+
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":       1,
+					"name":     body.Name,
+					"filename": body.Filename,
+					"content":  body.Content,
+				})
+			})),
+			ctx: context.Background(),
+			id:  1,
+			req: api.ScriptRequestBody{
+				Name:     "bootstrap",
+				Filename: "bootstrap.sh",
+				Content:  "apt-get upgrade",
+			},
+			wantResponse: &api.Script{
+				ID:       json.Number("1"),
+				Name:     "bootstrap",
+				Filename: "bootstrap.sh",
+				Content:  "apt-get upgrade",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			script, err := client.UpdateScript(test.ctx, test.id, test.req)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, script)
+		})
+	}
+}
+
+func TestDeleteScript(t *testing.T) {
+	tests := map[string]struct {
+		server  *httptest.Server
+		wantErr error
+		ctx     context.Context
+		id      int64
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "script not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error deleting script: %w", api.APIError{
+				ID:         1,
+				Message:    "script not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/account/scripts/0",
+				Body:       "{\"id\":1,\"message\":\"script not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/account/scripts/1", r.URL.Path)
+
+				w.WriteHeader(http.StatusOK)
+			})),
+			ctx: context.Background(),
+			id:  1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			err = client.DeleteScript(test.ctx, test.id)
+
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetAccountInformation(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		wantResponse  *api.AccountInformation
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting account information: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/account/accountInformation",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]int{1})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get account information response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/account/accountInformation", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"userId":                    1,
+					"userName":                  "Jane",
+					"userEmail":                 "jane@example.com",
+					"companyName":               "Example",
+					"isTeamMember":              false,
+					"accountBalance":            "12.34 EUR",
+					"accountBalanceRaw":         "1234",
+					"accountBalanceRawCurrency": "EUR",
+					"serverLimit":               10,
+				})
+			})),
+			ctx: context.Background(),
+			wantResponse: &api.AccountInformation{
+				UserID:                    1,
+				UserName:                  "Jane",
+				UserEmail:                 "jane@example.com",
+				CompanyName:               "Example",
+				AccountBalance:            "12.34 EUR",
+				AccountBalanceRaw:         json.Number("1234"),
+				AccountBalanceRawCurrency: "EUR",
+				ServerLimit:               10,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			account, err := client.GetAccountInformation(test.ctx)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, account)
+		})
+	}
+}
@@ -32,15 +32,6 @@ func (c *ClientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return http.DefaultTransport.RoundTrip(req)
 }
 
-type APIError struct {
-	ID      int    `json:"id"`
-	Message string `json:"message"`
-}
-
-func (e APIError) Error() string {
-	return e.Message
-}
-
 // Client which conforms to the OpenAPI3 specification for this service.
 type Client struct {
 	// The endpoint of the server conforming to this interface, with scheme,
@@ -56,6 +47,12 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// Policy for retrying failed requests, requests are not retried when nil
+	RetryPolicy *RetryPolicy
+
+	// Limit on how fast requests are sent, requests are not limited when nil
+	RateLimit *RateLimit
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -87,6 +84,15 @@ func NewClient(server string, opts ...ClientOption) (*Client, error) {
 		}
 	}
 
+	// retries go through the rate limiter as well so they can't exceed the limit
+	if client.RateLimit != nil {
+		client.Client = NewRateLimitDoer(client.Client, *client.RateLimit)
+	}
+
+	if client.RetryPolicy != nil {
+		client.Client = NewRetryDoer(client.Client, *client.RetryPolicy)
+	}
+
 	return &client, nil
 
 }
@@ -100,8 +106,30 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithRetryPolicy retries requests failing with 429, 5xx or transient network
+// errors, and server creates rejected for creating too many servers, according
+// to the given policy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		c.RetryPolicy = &policy
+		return nil
+	}
+}
+
+// WithRateLimit holds requests back so they don't exceed the given rate limit,
+// the limit is shared by every request made with the client.
+func WithRateLimit(limit RateLimit) ClientOption {
+	return func(c *Client) error {
+		c.RateLimit = &limit
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAccountInformation request
+	GetAccountInformation(ctx context.Context) (*AccountInformation, error)
+
 	// GetPublicKeys request
 	GetPublicKeys(ctx context.Context) (PublicKeys, error)
 
@@ -113,6 +141,9 @@ type ClientInterface interface {
 	// GetEvents request
 	GetEvents(ctx context.Context, params GetEventsParams) (Events, error)
 
+	// GetEventsPage request
+	GetEventsPage(ctx context.Context, params GetEventsParams) (*EventsPage, error)
+
 	// GetServersImages request
 	GetServersImages(ctx context.Context) (ServerImages, error)
 
@@ -134,6 +165,10 @@ type ClientInterface interface {
 	// GetServerBySlug request
 	GetServerBySlug(ctx context.Context, serverSlug string) (*Server, error)
 
+	GetServerMetrics(ctx context.Context, serverSlug string) (*ServerMetrics, error)
+
+	GetServerMetricsNow(ctx context.Context, serverSlug string) (*ServerMetrics, error)
+
 	PatchServer(ctx context.Context, serverSlug string, body PatchServerRequestBody) (*Server, error)
 
 	ReinstallServer(ctx context.Context, serverSlug string, body ReinstallServerRequestBody) (string, error)
@@ -142,6 +177,28 @@ type ClientInterface interface {
 
 	ResizeDryRun(ctx context.Context, serverSlug string, body ResizeServerRequestBody) (*ServerResize, error)
 
+	StartServer(ctx context.Context, serverSlug string) (string, error)
+
+	StopServer(ctx context.Context, serverSlug string) (string, error)
+
+	RebootServer(ctx context.Context, serverSlug string) (string, error)
+
+	SuspendServer(ctx context.Context, serverSlug string) (string, error)
+
+	SetServerMainDomain(ctx context.Context, serverSlug string, domain string) (string, error)
+
+	AddServerAlias(ctx context.Context, serverSlug string, domain string) (string, error)
+
+	RemoveServerAlias(ctx context.Context, serverSlug string, domain string) (string, error)
+
+	SetServerSSHPasswordAuth(ctx context.Context, serverSlug string, enabled bool) (string, error)
+
+	SetServerWordPressLockdown(ctx context.Context, serverSlug string, enabled bool) (string, error)
+
+	SetServerWebServer(ctx context.Context, serverSlug string, webServer string) (string, error)
+
+	SetServerPhpVersion(ctx context.Context, serverSlug string, phpVersion string) (string, error)
+
 	GetShellUsers(ctx context.Context, serverSlug string) (ShellUsers, error)
 
 	CreateShellUser(ctx context.Context, serverSlug string, shellUser CreateShellUserRequestBody) (*ShellUser, error)
@@ -149,4 +206,36 @@ type ClientInterface interface {
 	DeleteShellUser(ctx context.Context, serverSlug string, shellUserID int64) (string, error)
 
 	UpdateShellUserPublicKeys(ctx context.Context, serverSlug string, shellUserID int64, publicKeys []int) (*ShellUser, error)
+
+	UpdateShellUserPassword(ctx context.Context, serverSlug string, shellUserID int64, password string) (*ShellUser, error)
+
+	GetServerSnapshots(ctx context.Context, serverSlug string) (Snapshots, error)
+
+	CreateServerSnapshot(ctx context.Context, serverSlug string, body CreateSnapshotRequestBody) (*Snapshot, error)
+
+	DeleteServerSnapshot(ctx context.Context, serverSlug string, snapshotID int64) (string, error)
+
+	RestoreServerSnapshot(ctx context.Context, serverSlug string, body RestoreSnapshotRequestBody) (string, error)
+
+	GetScripts(ctx context.Context) (Scripts, error)
+
+	GetScript(ctx context.Context, scriptID int64) (*Script, error)
+
+	CreateScript(ctx context.Context, body ScriptRequestBody) (*Script, error)
+
+	UpdateScript(ctx context.Context, scriptID int64, body ScriptRequestBody) (*Script, error)
+
+	DeleteScript(ctx context.Context, scriptID int64) error
+
+	GetServerScripts(ctx context.Context, serverSlug string) (ServerScripts, error)
+
+	CreateServerScript(ctx context.Context, serverSlug string, body CreateServerScriptRequestBody) (*ServerScript, error)
+
+	DeleteServerScript(ctx context.Context, serverSlug string, serverScriptID int64) (string, error)
+
+	GetHooks(ctx context.Context) (Hooks, error)
+
+	CreateHook(ctx context.Context, body CreateHookRequestBody) (*Hook, error)
+
+	DeleteHook(ctx context.Context, hookID int64) error
 }
@@ -1,5 +1,15 @@
 package api
 
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
 // Price model
 type Price struct {
 	// Price amount
@@ -12,3 +22,43 @@ type Price struct {
 func errorStatus(code int) bool {
 	return code < 200 || code > 299
 }
+
+// callbackAction sends a request for an action the API runs in the background and returns the callback ID of the action,
+// body is sent as JSON unless it's nil
+func (c *Client) callbackAction(ctx context.Context, method string, path string, body interface{}, errorMessage string) (string, error) {
+	var bodyReader io.Reader
+
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return "", err
+		}
+
+		bodyReader = bytes.NewReader(buf)
+	}
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL.Path += path
+
+	req, err := http.NewRequestWithContext(ctx, method, serverURL.String(), bodyReader)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return "", fmt.Errorf("%s: %w", errorMessage, newAPIError(res))
+	}
+
+	return res.Header.Get("X-Callback-Id"), nil
+}
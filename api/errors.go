@@ -1,7 +1,133 @@
 package api
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
 
 var (
-	ErrServerNotFound = errors.New("server not found")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrActionFailed = errors.New("action failed")
 )
+
+// APIError is returned for every response with an error status
+type APIError struct {
+	ID      int    `json:"id"`
+	Message string `json:"message"`
+
+	// HTTP status code of the response
+	StatusCode int `json:"-"`
+
+	// Method and path of the request that failed
+	Method string `json:"-"`
+	Path   string `json:"-"`
+
+	// Raw response body
+	Body string `json:"-"`
+}
+
+func (e APIError) Error() string {
+	return e.Message
+}
+
+// Is lets errors.Is match an APIError against the sentinel errors by status code
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+
+	return false
+}
+
+// ActionError is returned when the event of an action finishes with an error status
+type ActionError struct {
+	Event EventLog
+}
+
+func (e ActionError) Error() string {
+	message := fmt.Sprintf("%s event (%s) %s", e.Event.EventType, e.Event.Id, e.Event.Status)
+
+	if e.Event.Message != "" {
+		message += ": " + e.Event.Message
+	}
+
+	return message
+}
+
+// Is lets errors.Is match an ActionError against ErrActionFailed
+func (e ActionError) Is(target error) bool {
+	return target == ErrActionFailed
+}
+
+// Detail describes the failed action along with its event's action data and timings
+func (e ActionError) Detail() string {
+	lines := []string{
+		"Event ID: " + e.Event.Id.String(),
+		"Action: " + e.Event.Action,
+	}
+
+	if e.Event.ActionData != "" {
+		lines = append(lines, "Action data: "+e.Event.ActionData)
+	}
+
+	if e.Event.Message != "" {
+		lines = append(lines, "Message: "+e.Event.Message)
+	}
+
+	lines = append(lines, "Started: "+e.Event.StartTime, "Ended: "+e.Event.EndTime)
+
+	return strings.Join(lines, "\n")
+}
+
+// maxErrorBodySize is how much of an error response body is kept on an APIError
+const maxErrorBodySize = 512
+
+// newAPIError reads an error response into an APIError along with the request and response details,
+// bodies that aren't a JSON API error, like a load balancer's HTML error page, are kept as the message
+func newAPIError(res *http.Response) APIError {
+	apiError := APIError{
+		StatusCode: res.StatusCode,
+	}
+
+	if res.Request != nil {
+		apiError.Method = res.Request.Method
+		apiError.Path = res.Request.URL.Path
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize+1))
+
+	if err := json.Unmarshal(body, &apiError); err != nil || apiError.Message == "" {
+		apiError.ID = 0
+		apiError.Message = fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode))
+
+		if raw := truncateErrorBody(body); raw != "" {
+			apiError.Message += ": " + raw
+		}
+	}
+
+	apiError.Body = truncateErrorBody(body)
+
+	return apiError
+}
+
+func truncateErrorBody(body []byte) string {
+	if len(body) > maxErrorBodySize {
+		return strings.TrimSpace(string(body[:maxErrorBodySize])) + "..."
+	}
+
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,192 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	sentinels := []error{api.ErrNotFound, api.ErrConflict, api.ErrRateLimited, api.ErrUnauthorized}
+
+	tests := map[string]struct {
+		status int
+		want   error
+	}{
+		"when not found": {
+			status: http.StatusNotFound,
+			want:   api.ErrNotFound,
+		},
+		"when conflict": {
+			status: http.StatusConflict,
+			want:   api.ErrConflict,
+		},
+		"when rate limited": {
+			status: http.StatusTooManyRequests,
+			want:   api.ErrRateLimited,
+		},
+		"when unauthorized": {
+			status: http.StatusUnauthorized,
+			want:   api.ErrUnauthorized,
+		},
+		"when forbidden": {
+			status: http.StatusForbidden,
+			want:   api.ErrUnauthorized,
+		},
+		"when internal server error": {
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": http.StatusText(test.status),
+				})
+			}))
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL)
+
+			assert.Nil(t, err)
+
+			_, err = client.GetServerBySlug(context.Background(), "test")
+
+			apiError := api.APIError{}
+
+			assert.True(t, errors.As(err, &apiError))
+
+			assert.Equal(t, test.status, apiError.StatusCode)
+
+			assert.Equal(t, "GET", apiError.Method)
+
+			assert.Equal(t, "/servers/test", apiError.Path)
+
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == test.want, errors.Is(err, sentinel), sentinel.Error())
+			}
+		})
+	}
+}
+
+func TestAPIErrorBody(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		wantErr api.APIError
+	}{
+		"when body is empty": {
+			status: http.StatusServiceUnavailable,
+			wantErr: api.APIError{
+				Message:    "503 Service Unavailable",
+				StatusCode: http.StatusServiceUnavailable,
+				Method:     "GET",
+				Path:       "/servers/test",
+			},
+		},
+		"when body is json without a message": {
+			status: http.StatusInternalServerError,
+			body:   `{"error":"unexpected"}`,
+			wantErr: api.APIError{
+				Message:    `500 Internal Server Error: {"error":"unexpected"}`,
+				StatusCode: http.StatusInternalServerError,
+				Method:     "GET",
+				Path:       "/servers/test",
+				Body:       `{"error":"unexpected"}`,
+			},
+		},
+		"when body is too long": {
+			status: http.StatusBadGateway,
+			body:   strings.Repeat("a", 1024),
+			wantErr: api.APIError{
+				Message:    "502 Bad Gateway: " + strings.Repeat("a", 512) + "...",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/servers/test",
+				Body:       strings.Repeat("a", 512) + "...",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL)
+
+			assert.Nil(t, err)
+
+			_, err = client.GetServerBySlug(context.Background(), "test")
+
+			assert.Equal(t, fmt.Errorf("error getting server by slug: %w", test.wantErr), err)
+		})
+	}
+}
+
+func TestActionError(t *testing.T) {
+	tests := map[string]struct {
+		err        api.ActionError
+		wantError  string
+		wantDetail string
+	}{
+		"when event has a message and action data": {
+			err: api.ActionError{
+				Event: api.EventLog{
+					Id:         json.Number("7"),
+					Action:     "Reinstall server",
+					ActionData: "reinstall:webdock-ubuntu-jammy-cloud",
+					EventType:  "reinstall",
+					Message:    "image not available",
+					StartTime:  "25/10/2022 05:11:34",
+					EndTime:    "25/10/2022 05:13:34",
+					Status:     api.EventStatusError,
+				},
+			},
+			wantError:  "reinstall event (7) error: image not available",
+			wantDetail: "Event ID: 7\nAction: Reinstall server\nAction data: reinstall:webdock-ubuntu-jammy-cloud\nMessage: image not available\nStarted: 25/10/2022 05:11:34\nEnded: 25/10/2022 05:13:34",
+		},
+		"when event has no message": {
+			err: api.ActionError{
+				Event: api.EventLog{
+					Id:        json.Number("8"),
+					Action:    "Stop server",
+					EventType: "stop",
+					Status:    api.EventStatusError,
+				},
+			},
+			wantError:  "stop event (8) error",
+			wantDetail: "Event ID: 8\nAction: Stop server\nStarted: \nEnded: ",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := fmt.Errorf("waiting for action: %w", test.err)
+
+			assert.Equal(t, test.wantError, test.err.Error())
+
+			assert.Equal(t, test.wantDetail, test.err.Detail())
+
+			assert.True(t, errors.Is(err, api.ErrActionFailed))
+
+			assert.False(t, errors.Is(err, api.ErrNotFound))
+		})
+	}
+}
@@ -6,61 +6,124 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/google/go-querystring/query"
 )
 
+// EventTypes are the event types events are logged under, GetEventsParams.EventType and hooks filter on these
+var EventTypes = []string{
+	"provision",
+	"restore-snapshot",
+	"change-profile",
+	"password-change",
+	"reinstall",
+	"set-hostnames",
+	"set-mainDomain",
+	"delete-alias",
+	"create-alias",
+	"set-webserver",
+	"set-php-version",
+	"set-php-settings",
+	"toggle-wordpress-lockdown",
+	"toggle-ssh-password-auth",
+	"start",
+	"stop",
+	"reboot",
+	"suspend",
+	"delete",
+	"create-snapshot",
+	"delete-snapshot",
+	"create-shell-user",
+	"delete-shell-user",
+	"set-shell-user-keys",
+	"set-shell-user-password",
+	"deploy-script",
+	"delete-script",
+	"execute-script",
+}
+
+// Statuses an event moves through, an event ends either finished or with an error
+const (
+	EventStatusWaiting  = "waiting"
+	EventStatusWorking  = "working"
+	EventStatusFinished = "finished"
+	EventStatusError    = "error"
+)
+
 // GetEventsParams defines parameters for GetEvents.
 type GetEventsParams struct {
 	// Callback ID
-	CallbackId string `json:"callbackId,omitempty"`
+	CallbackId string `json:"callbackId,omitempty" url:"callbackId,omitempty"`
 
-	// Event Type
-	EventType string `json:"eventType,omitempty"`
+	// Event Type, one of EventTypes
+	EventType string `json:"eventType,omitempty" url:"eventType,omitempty"`
 
 	// Page
-	Page int64 `json:"page,omitempty"`
+	Page int64 `json:"page,omitempty" url:"page,omitempty"`
 
 	// Events per page
-	PerPage int64 `json:"per_page,omitempty"`
+	PerPage int64 `json:"per_page,omitempty" url:"per_page,omitempty"`
 }
 
 // Event log model
 type EventLog struct {
 	// Just a plain text description of the action. Same text as you see in the Event Log in the Webdock Dashboard.
-	Action string `json:"action,omitempty"`
+	Action string `json:"action,omitempty" mapstructure:"action"`
 
 	// Action Data. A more static/parseable string representation of the action.
-	ActionData string `json:"actionData,omitempty"`
+	ActionData string `json:"actionData,omitempty" mapstructure:"action_data"`
 
 	// Callback ID
-	CallbackId string `json:"callbackId,omitempty"`
+	CallbackId string `json:"callbackId,omitempty" mapstructure:"callback_id"`
 
 	// End Time of the event
-	EndTime string `json:"endTime"`
+	EndTime string `json:"endTime" mapstructure:"end_time"`
 
 	// Event Type
-	EventType string `json:"eventType,omitempty"`
+	EventType string `json:"eventType,omitempty" mapstructure:"event_type"`
 
 	// Event log ID
-	Id json.Number `json:"id,omitempty"`
+	Id json.Number `json:"id,omitempty" mapstructure:"id"`
 
 	// Any &quot;Message&quot; or return data from the action once finished executing.
-	Message string `json:"message,omitempty"`
+	Message string `json:"message,omitempty" mapstructure:"message"`
 
 	// Server Slug
-	ServerSlug string `json:"serverSlug,omitempty"`
+	ServerSlug string `json:"serverSlug,omitempty" mapstructure:"server_slug"`
 
 	// Start Time of the event
-	StartTime string `json:"startTime,omitempty"`
+	StartTime string `json:"startTime,omitempty" mapstructure:"start_time"`
 
 	// Status
-	Status string `json:"status,omitempty"`
+	Status string `json:"status,omitempty" mapstructure:"status"`
 }
 
 type Events []EventLog
 
+// EventsPage is a single page of events
+type EventsPage struct {
+	// Events on the page
+	Events Events
+
+	// Total number of events matching the parameters, -1 when the API didn't report it
+	Total int64
+
+	// Number of the next page, 0 when this is the last page
+	NextPage int64
+}
+
 func (c *Client) GetEvents(ctx context.Context, params GetEventsParams) (Events, error) {
+	page, err := c.GetEventsPage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Events, nil
+}
+
+func (c *Client) GetEventsPage(ctx context.Context, params GetEventsParams) (*EventsPage, error) {
 	serverURL, err := url.Parse(c.Server)
 	if err != nil {
 		return nil, err
@@ -88,13 +151,7 @@ func (c *Client) GetEvents(ctx context.Context, params GetEventsParams) (Events,
 	defer resp.Body.Close()
 
 	if errorStatus(resp.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(resp.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get events error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting events: %w", apiError)
+		return nil, fmt.Errorf("error getting events: %w", newAPIError(resp))
 	}
 
 	events := Events{}
@@ -103,5 +160,159 @@ func (c *Client) GetEvents(ctx context.Context, params GetEventsParams) (Events,
 		return nil, fmt.Errorf("error decoding get events response body: %w", err)
 	}
 
-	return events, nil
+	page := EventsPage{
+		Events: events,
+		Total:  -1,
+	}
+
+	if total, err := strconv.ParseInt(resp.Header.Get("X-Total-Count"), 10, 64); err == nil {
+		page.Total = total
+	}
+
+	page.NextPage = nextEventsPage(params, page, resp.Header.Get("Link"))
+
+	return &page, nil
+}
+
+// maxUnreportedEventsPages bounds paging when the API reports neither a next link nor a total, so an API ignoring the
+// page parameter and returning the same full page every time isn't paged through forever
+const maxUnreportedEventsPages = 1000
+
+// nextEventsPage works out the page following the one requested with params, preferring a
+// next link, then the reported total and finally whether the page came back full
+func nextEventsPage(params GetEventsParams, page EventsPage, link string) int64 {
+	current := params.Page
+	if current < 1 {
+		current = 1
+	}
+
+	if link != "" {
+		next, ok := nextLinkPage(link)
+		if !ok || next <= current {
+			return 0
+		}
+
+		return next
+	}
+
+	if len(page.Events) == 0 {
+		return 0
+	}
+
+	if page.Total >= 0 {
+		if params.PerPage <= 0 || current*params.PerPage >= page.Total {
+			return 0
+		}
+
+		return current + 1
+	}
+
+	if params.PerPage > 0 && int64(len(page.Events)) < params.PerPage {
+		return 0
+	}
+
+	if current >= maxUnreportedEventsPages {
+		return 0
+	}
+
+	return current + 1
+}
+
+// nextLinkPage returns the page number of the rel="next" entry of an RFC 8288 Link header
+func nextLinkPage(link string) (int64, bool) {
+	for _, entry := range strings.Split(link, ",") {
+		parts := strings.Split(entry, ";")
+
+		target := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+
+		for _, param := range parts[1:] {
+			if strings.ReplaceAll(strings.TrimSpace(param), " ", "") != `rel="next"` {
+				continue
+			}
+
+			nextURL, err := url.Parse(target)
+			if err != nil {
+				return 0, false
+			}
+
+			next, err := strconv.ParseInt(nextURL.Query().Get("page"), 10, 64)
+			if err != nil {
+				return 0, false
+			}
+
+			return next, true
+		}
+	}
+
+	return 0, false
+}
+
+// EventsPager fetches a single page of events
+type EventsPager interface {
+	GetEventsPage(ctx context.Context, params GetEventsParams) (*EventsPage, error)
+}
+
+// EventIterator walks every page of events matching the parameters it was created with
+type EventIterator struct {
+	pager  EventsPager
+	params GetEventsParams
+	events Events
+	index  int
+	done   bool
+	err    error
+}
+
+// NewEventIterator creates an iterator starting at params.Page, or the first page when it's not set
+func NewEventIterator(pager EventsPager, params GetEventsParams) *EventIterator {
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	return &EventIterator{
+		pager:  pager,
+		params: params,
+		index:  -1,
+	}
+}
+
+// Next advances to the next event, fetching the next page when needed, and reports whether there is one
+func (it *EventIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+
+	for it.index >= len(it.events) {
+		if it.done {
+			return false
+		}
+
+		page, err := it.pager.GetEventsPage(ctx, it.params)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.events = page.Events
+		it.index = 0
+
+		if page.NextPage == 0 {
+			it.done = true
+		}
+
+		it.params.Page = page.NextPage
+	}
+
+	return true
+}
+
+// Event returns the current event
+func (it *EventIterator) Event() EventLog {
+	return it.events[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *EventIterator) Err() error {
+	return it.err
 }
@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -29,23 +30,27 @@ func TestGetEvents(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error getting events: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error getting events: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/events",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      true,
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding get events error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "bool",
-				Offset: 10,
+			wantErr: fmt.Errorf("error getting events: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/events",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -127,3 +132,198 @@ func TestGetEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEventsPage(t *testing.T) {
+	tests := map[string]struct {
+		handler      http.HandlerFunc
+		params       api.GetEventsParams
+		wantTotal    int64
+		wantNextPage int64
+	}{
+		"when link header has a next page": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Link", `<https://api.webdock.io/v1/events?page=1&per_page=2>; rel="prev", <https://api.webdock.io/v1/events?page=3&per_page=2>; rel="next"`)
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}})
+			},
+			params:       api.GetEventsParams{Page: 2, PerPage: 2},
+			wantTotal:    -1,
+			wantNextPage: 3,
+		},
+		"when link header has no next page": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Link", `<https://api.webdock.io/v1/events?page=1&per_page=2>; rel="prev"`)
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}, {"id": 2}})
+			},
+			params:    api.GetEventsParams{Page: 2, PerPage: 2},
+			wantTotal: -1,
+		},
+		"when total is larger than the pages fetched": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Total-Count", "5")
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}, {"id": 2}})
+			},
+			params:       api.GetEventsParams{Page: 2, PerPage: 2},
+			wantTotal:    5,
+			wantNextPage: 3,
+		},
+		"when total is reached": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Total-Count", "4")
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}, {"id": 2}})
+			},
+			params:    api.GetEventsParams{Page: 2, PerPage: 2},
+			wantTotal: 4,
+		},
+		"when page is full": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}, {"id": 2}})
+			},
+			params:       api.GetEventsParams{PerPage: 2},
+			wantTotal:    -1,
+			wantNextPage: 2,
+		},
+		"when page is full but the page limit is reached": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}, {"id": 2}})
+			},
+			params:    api.GetEventsParams{Page: 1000, PerPage: 2},
+			wantTotal: -1,
+		},
+		"when page is not full": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{{"id": 1}})
+			},
+			params:    api.GetEventsParams{PerPage: 2},
+			wantTotal: -1,
+		},
+		"when page is empty": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{})
+			},
+			wantTotal: -1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(test.handler)
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL)
+
+			assert.Nil(t, err)
+
+			page, err := client.GetEventsPage(context.Background(), test.params)
+
+			assert.Nil(t, err)
+
+			assert.Equal(t, test.wantTotal, page.Total)
+
+			assert.Equal(t, test.wantNextPage, page.NextPage)
+		})
+	}
+}
+
+func TestGetEventsPageQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "callbackId=3AtrHlEVRg&eventType=start&page=2&per_page=10", r.URL.RawQuery)
+
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{})
+	}))
+
+	defer server.Close()
+
+	client, err := api.NewClient(server.URL)
+
+	assert.Nil(t, err)
+
+	_, err = client.GetEventsPage(context.Background(), api.GetEventsParams{
+		CallbackId: "3AtrHlEVRg",
+		EventType:  "start",
+		Page:       2,
+		PerPage:    10,
+	})
+
+	assert.Nil(t, err)
+}
+
+type fakeEventsPager struct {
+	pages  map[int64]*api.EventsPage
+	err    error
+	called []int64
+}
+
+func (p *fakeEventsPager) GetEventsPage(ctx context.Context, params api.GetEventsParams) (*api.EventsPage, error) {
+	p.called = append(p.called, params.Page)
+
+	if page, ok := p.pages[params.Page]; ok {
+		return page, nil
+	}
+
+	return nil, p.err
+}
+
+func TestEventIterator(t *testing.T) {
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		pager      *fakeEventsPager
+		wantIDs    []string
+		wantErr    error
+		wantCalled []int64
+	}{
+		"when every page is walked": {
+			pager: &fakeEventsPager{
+				pages: map[int64]*api.EventsPage{
+					1: {Events: api.Events{{Id: "1"}, {Id: "2"}}, NextPage: 2},
+					2: {Events: api.Events{{Id: "3"}}},
+				},
+			},
+			wantIDs:    []string{"1", "2", "3"},
+			wantCalled: []int64{1, 2},
+		},
+		"when a page is empty": {
+			pager: &fakeEventsPager{
+				pages: map[int64]*api.EventsPage{
+					1: {Events: api.Events{{Id: "1"}}, NextPage: 2},
+					2: {Events: api.Events{}, NextPage: 3},
+					3: {Events: api.Events{{Id: "2"}}},
+				},
+			},
+			wantIDs:    []string{"1", "2"},
+			wantCalled: []int64{1, 2, 3},
+		},
+		"when getting a page fails": {
+			pager: &fakeEventsPager{
+				pages: map[int64]*api.EventsPage{
+					1: {Events: api.Events{{Id: "1"}}, NextPage: 2},
+				},
+				err: mockErr,
+			},
+			wantIDs:    []string{"1"},
+			wantErr:    mockErr,
+			wantCalled: []int64{1, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			it := api.NewEventIterator(test.pager, api.GetEventsParams{})
+
+			ids := []string{}
+
+			for it.Next(ctx) {
+				ids = append(ids, it.Event().Id.String())
+			}
+
+			assert.False(t, it.Next(ctx))
+
+			assert.Equal(t, test.wantIDs, ids)
+
+			assert.Equal(t, test.wantErr, it.Err())
+
+			assert.Equal(t, test.wantCalled, test.pager.called)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	HookTypeWebhook = "webhook"
+
+	HookFilterEventType  = "eventType"
+	HookFilterCallbackID = "callbackId"
+)
+
+// HookFilter model
+type HookFilter struct {
+	// Filter type, either eventType or callbackId
+	Type string `json:"type"`
+
+	// Value the filter matches
+	Value string `json:"value"`
+}
+
+// Hook model
+type Hook struct {
+	// Hook ID
+	ID json.Number `json:"id,omitempty"`
+
+	// URL events are posted to
+	CallbackURL string `json:"callbackUrl,omitempty"`
+
+	// Filters limiting which events are posted
+	Filters []HookFilter `json:"filters,omitempty"`
+}
+
+// Hooks is a collection of Hook
+type Hooks []Hook
+
+// Create hook model
+type CreateHookRequestBody struct {
+	// Hook type
+	HookType string `json:"hookType"`
+
+	// URL events are posted to
+	HookValue string `json:"hookValue"`
+
+	// Only post events with this callback ID
+	CallbackID string `json:"callbackId,omitempty"`
+
+	// Only post events of this type, one of EventTypes
+	EventType string `json:"eventType,omitempty"`
+}
+
+func (c *Client) GetHooks(ctx context.Context) (Hooks, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += "hooks"
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hooks: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hooks: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting hooks: %w", newAPIError(res))
+	}
+
+	hooks := Hooks{}
+
+	if err = json.NewDecoder(res.Body).Decode(&hooks); err != nil {
+		return nil, fmt.Errorf("error decoding get hooks response body: %w", err)
+	}
+
+	return hooks, nil
+}
+
+func (c *Client) CreateHook(ctx context.Context, body CreateHookRequestBody) (*Hook, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += "hooks"
+
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating hook: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating hook: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error creating hook: %w", newAPIError(res))
+	}
+
+	hook := Hook{}
+
+	if err = json.NewDecoder(res.Body).Decode(&hook); err != nil {
+		return nil, fmt.Errorf("error decoding create hook response body: %w", err)
+	}
+
+	return &hook, nil
+}
+
+func (c *Client) DeleteHook(ctx context.Context, hookID int64) error {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return err
+	}
+
+	serverURL.Path += fmt.Sprintf("hooks/%d", hookID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", serverURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("error deleting hook: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error deleting hook: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return fmt.Errorf("error deleting hook: %w", newAPIError(res))
+	}
+
+	return nil
+}
+
+// Filter returns the value of the hook's filter of the given type
+func (h Hook) Filter(filterType string) string {
+	for _, filter := range h.Filters {
+		if filter.Type == filterType {
+			return filter.Value
+		}
+	}
+
+	return ""
+}
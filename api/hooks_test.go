@@ -0,0 +1,238 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetHooks(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		wantResponse  api.Hooks
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting hooks: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/hooks",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id": 1,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get hooks response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/hooks", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+					{
+						"id":          1,
+						"callbackUrl": "https://example.com/hook",
+						"filters": []map[string]interface{}{
+							{
+								"type":  "eventType",
+								"value": "provision",
+							},
+						},
+					},
+				})
+			})),
+			ctx: context.Background(),
+			wantResponse: api.Hooks{
+				{
+					ID:          json.Number("1"),
+					CallbackURL: "https://example.com/hook",
+					Filters: []api.HookFilter{
+						{
+							Type:  api.HookFilterEventType,
+							Value: "provision",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			hooks, err := client.GetHooks(test.ctx)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, hooks)
+		})
+	}
+}
+
+func TestCreateHook(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		req          api.CreateHookRequestBody
+		wantResponse *api.Hook
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "invalid hook value",
+				})
+			})),
+			wantErr: fmt.Errorf("error creating hook: %w", api.APIError{
+				ID:         1,
+				Message:    "invalid hook value",
+				StatusCode: http.StatusBadRequest,
+				Method:     "POST",
+				Path:       "/hooks",
+				Body:       "{\"id\":1,\"message\":\"invalid hook value\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/hooks", r.URL.Path)
+
+				body := api.CreateHookRequestBody{}
+
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, api.CreateHookRequestBody{
+					HookType:  api.HookTypeWebhook,
+					HookValue: "https://example.com/hook",
+					EventType: "provision",
+				}, body)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":          2,
+					"callbackUrl": body.HookValue,
+					"filters": []map[string]interface{}{
+						{
+							"type":  "eventType",
+							"value": body.EventType,
+						},
+					},
+				})
+			})),
+			ctx: context.Background(),
+			req: api.CreateHookRequestBody{
+				HookType:  api.HookTypeWebhook,
+				HookValue: "https://example.com/hook",
+				EventType: "provision",
+			},
+			wantResponse: &api.Hook{
+				ID:          json.Number("2"),
+				CallbackURL: "https://example.com/hook",
+				Filters: []api.HookFilter{
+					{
+						Type:  api.HookFilterEventType,
+						Value: "provision",
+					},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			hook, err := client.CreateHook(test.ctx, test.req)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, hook)
+		})
+	}
+}
+
+func TestDeleteHook(t *testing.T) {
+	tests := map[string]struct {
+		server  *httptest.Server
+		wantErr error
+		ctx     context.Context
+		id      int64
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "hook not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error deleting hook: %w", api.APIError{
+				ID:         1,
+				Message:    "hook not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/hooks/0",
+				Body:       "{\"id\":1,\"message\":\"hook not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/hooks/1", r.URL.Path)
+			})),
+			ctx: context.Background(),
+			id:  1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			err = client.DeleteHook(test.ctx, test.id)
+
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -58,13 +58,7 @@ func (c *Client) GetPublicKeys(ctx context.Context) (PublicKeys, error) {
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get public keys error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting public keys: %w", apiError)
+		return nil, fmt.Errorf("error getting public keys: %w", newAPIError(res))
 	}
 
 	var publicKeys PublicKeys
@@ -93,7 +87,7 @@ func (c *Client) CreatePublicKey(ctx context.Context, body CreatePublicKeyReques
 
 	serverURL.Path += "account/publicKeys"
 
-	req, err := http.NewRequestWithContext(ctx, "POST", serverURL.String(), bodyReader)
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("error creating public key: %w", err)
 	}
@@ -106,13 +100,7 @@ func (c *Client) CreatePublicKey(ctx context.Context, body CreatePublicKeyReques
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding create public key error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error creating public key: %w", apiError)
+		return nil, fmt.Errorf("error creating public key: %w", newAPIError(res))
 	}
 
 	publicKey := &PublicKey{}
@@ -145,13 +133,7 @@ func (c *Client) DeletePublicKey(ctx context.Context, id int64) error {
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return fmt.Errorf("error decoding delete public key error response body: %w", err)
-		}
-
-		return fmt.Errorf("error deleting public key: %w", apiError)
+		return fmt.Errorf("error deleting public key: %w", newAPIError(res))
 	}
 
 	return nil
@@ -28,23 +28,27 @@ func TestGetPublicKeys(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error getting public keys: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error getting public keys: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/account/publicKeys",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding get public keys error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error getting public keys: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/account/publicKeys",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -131,23 +135,27 @@ func TestCreatePublicKey(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error creating public key: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error creating public key: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "POST",
+				Path:       "/account/publicKeys",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding create public key error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error creating public key: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "POST",
+				Path:       "/account/publicKeys",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -223,23 +231,27 @@ func TestDeletePublicKey(t *testing.T) {
 					"message": "public key not found",
 				})
 			})),
-			wantErr: fmt.Errorf("error deleting public key: %w", api.APIError{ID: 1, Message: "public key not found"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error deleting public key: %w", api.APIError{
+				ID:         1,
+				Message:    "public key not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/account/publicKeys/0",
+				Body:       "{\"id\":1,\"message\":\"public key not found\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding delete public key error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error deleting public key: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "DELETE",
+				Path:       "/account/publicKeys/0",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
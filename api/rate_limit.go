@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimit configures how fast requests are sent to the API, a rate of zero or less disables the limit
+type RateLimit struct {
+	// Number of requests sent per second across all endpoints
+	RequestsPerSecond float64
+
+	// Number of requests that can be sent at once before the rate applies
+	Burst int
+
+	// Number of requests creating a resource sent per second, on top of the overall rate
+	CreateRequestsPerSecond float64
+
+	// Number of create requests that can be sent at once before the rate applies
+	CreateBurst int
+}
+
+// TokenBucket is a token bucket rate limiter safe for concurrent use
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	delay := b.reserve()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token, letting the bucket go negative so waiters are served in order,
+// and returns how long the caller has to wait before the token is actually available
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+
+	b.last = now
+
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a caller that stopped waiting
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	b.tokens++
+	b.mu.Unlock()
+}
+
+type createOperationKey struct{}
+
+// withCreateOperation marks requests made with ctx as creating a resource so only they are held back by the create
+// limit, actions on existing resources are POSTs too but aren't limited by it
+func withCreateOperation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, createOperationKey{}, true)
+}
+
+func isCreateOperation(req *http.Request) bool {
+	create, _ := req.Context().Value(createOperationKey{}).(bool)
+
+	return create
+}
+
+// RateLimitDoer wraps a HttpRequestDoer and holds requests back until the rate limit allows them
+type RateLimitDoer struct {
+	doer   HttpRequestDoer
+	all    *TokenBucket
+	create *TokenBucket
+}
+
+func NewRateLimitDoer(doer HttpRequestDoer, limit RateLimit) *RateLimitDoer {
+	r := &RateLimitDoer{
+		doer: doer,
+	}
+
+	if limit.RequestsPerSecond > 0 {
+		r.all = NewTokenBucket(limit.RequestsPerSecond, limit.Burst)
+	}
+
+	if limit.CreateRequestsPerSecond > 0 {
+		r.create = NewTokenBucket(limit.CreateRequestsPerSecond, limit.CreateBurst)
+	}
+
+	return r
+}
+
+func (r *RateLimitDoer) Do(req *http.Request) (*http.Response, error) {
+	if r.create != nil && isCreateOperation(req) {
+		if err := r.create.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.all != nil {
+		if err := r.all.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.doer.Do(req)
+}
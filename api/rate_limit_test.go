@@ -0,0 +1,152 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := api.NewTokenBucket(20, 2)
+
+	start := time.Now()
+
+	// the first two requests use the burst, the following two have to wait 50ms each
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, bucket.Wait(context.Background()))
+	}
+
+	elapsed := time.Since(start)
+
+	assert.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestTokenBucketCanceled(t *testing.T) {
+	bucket := api.NewTokenBucket(0.1, 1)
+
+	assert.Nil(t, bucket.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, bucket.Wait(ctx))
+}
+
+func TestRateLimit(t *testing.T) {
+	tests := map[string]struct {
+		limit       api.RateLimit
+		requests    int
+		call        func(client *api.Client) error
+		wantAtLeast time.Duration
+	}{
+		"when requests are limited": {
+			limit: api.RateLimit{
+				RequestsPerSecond: 20,
+				Burst:             1,
+			},
+			requests: 5,
+			call: func(client *api.Client) error {
+				_, err := client.GetHooks(context.Background())
+				return err
+			},
+			wantAtLeast: 200 * time.Millisecond,
+		},
+		"when create requests are limited": {
+			limit: api.RateLimit{
+				CreateRequestsPerSecond: 10,
+				CreateBurst:             1,
+			},
+			requests: 3,
+			call: func(client *api.Client) error {
+				_, err := client.CreateHook(context.Background(), api.CreateHookRequestBody{})
+				return err
+			},
+			wantAtLeast: 200 * time.Millisecond,
+		},
+		"when only create requests are limited": {
+			limit: api.RateLimit{
+				CreateRequestsPerSecond: 0.1,
+				CreateBurst:             1,
+			},
+			requests: 5,
+			call: func(client *api.Client) error {
+				_, err := client.GetHooks(context.Background())
+				return err
+			},
+		},
+		"when actions on existing resources are not create requests": {
+			limit: api.RateLimit{
+				CreateRequestsPerSecond: 0.1,
+				CreateBurst:             1,
+			},
+			requests: 5,
+			call: func(client *api.Client) error {
+				_, err := client.AddServerAlias(context.Background(), "server1", "example.com")
+				return err
+			},
+		},
+		"when limit is disabled": {
+			requests: 5,
+			call: func(client *api.Client) error {
+				_, err := client.CreateHook(context.Background(), api.CreateHookRequestBody{})
+				return err
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+
+				if r.Method == http.MethodGet {
+					_, _ = w.Write([]byte("[]"))
+				} else {
+					_, _ = w.Write([]byte("{}"))
+				}
+			}))
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL, api.WithRateLimit(test.limit))
+
+			assert.Nil(t, err)
+
+			start := time.Now()
+
+			wg := sync.WaitGroup{}
+
+			for i := 0; i < test.requests; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					assert.Nil(t, test.call(client))
+				}()
+			}
+
+			wg.Wait()
+
+			elapsed := time.Since(start)
+
+			assert.GreaterOrEqual(t, elapsed, test.wantAtLeast)
+
+			assert.Less(t, elapsed, test.wantAtLeast+time.Second)
+
+			assert.Equal(t, int32(test.requests), atomic.LoadInt32(&requests))
+		})
+	}
+}
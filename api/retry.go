@@ -0,0 +1,239 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRetryMinBackoff = 1 * time.Second
+	DefaultRetryMaxBackoff = 30 * time.Second
+
+	// Webdock only lets an account create a few servers in a short window, waiting it out takes minutes
+	DefaultTooManyServersBackoff = 1 * time.Minute
+)
+
+const tooManyServersMessage = "You are creating too many servers in too short of a timespan"
+
+// RetryPolicy configures how requests failing with 429, 5xx or transient network errors are retried,
+// as well as server creates rejected for creating too many servers in too short of a timespan
+type RetryPolicy struct {
+	// Number of times a request is retried after the first attempt
+	MaxRetries int
+
+	// Backoff before the first retry, doubled on every following retry
+	MinBackoff time.Duration
+
+	// Upper bound for the backoff between retries, including the delay asked for by a Retry-After header
+	MaxBackoff time.Duration
+
+	// Backoff before the first retry of a server create rejected for creating too many servers,
+	// doubled on every following retry and not bound by MaxBackoff
+	TooManyServersBackoff time.Duration
+}
+
+// RetryDoer wraps a HttpRequestDoer and retries failed requests with jittered exponential backoff.
+// Requests that aren't idempotent are only retried when the API could not have acted on them,
+// that is when they were rate limited or the connection could not be established.
+type RetryDoer struct {
+	doer   HttpRequestDoer
+	policy RetryPolicy
+}
+
+func NewRetryDoer(doer HttpRequestDoer, policy RetryPolicy) *RetryDoer {
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = DefaultRetryMinBackoff
+	}
+
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DefaultRetryMaxBackoff
+	}
+
+	if policy.TooManyServersBackoff <= 0 {
+		policy.TooManyServersBackoff = DefaultTooManyServersBackoff
+	}
+
+	return &RetryDoer{
+		doer:   doer,
+		policy: policy,
+	}
+}
+
+func (r *RetryDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := r.doer.Do(attemptReq)
+
+		if attempt >= r.policy.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		delay := r.backoff(attempt, res)
+
+		// the retry could never be sent before the deadline, the last response tells more than the context error
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt, preferring the delay asked for by the API
+func (r *RetryDoer) backoff(attempt int, res *http.Response) time.Duration {
+	if isTooManyServers(res) {
+		backoff := r.policy.TooManyServersBackoff << attempt
+		if backoff <= 0 {
+			backoff = r.policy.TooManyServersBackoff
+		}
+
+		return jitter(backoff)
+	}
+
+	if res != nil {
+		if delay, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(delay, r.policy.MaxBackoff)
+		}
+	}
+
+	backoff := r.policy.MinBackoff << attempt
+	if backoff <= 0 || backoff > r.policy.MaxBackoff {
+		backoff = r.policy.MaxBackoff
+	}
+
+	return jitter(backoff)
+}
+
+func jitter(backoff time.Duration) time.Duration {
+	// equal jitter, wait at least half of the backoff so retries from parallel resources spread out
+	half := backoff / 2
+
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// rewindRequest returns a copy of the request with a fresh body so it can be sent more than once
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		clone.Body = body
+	}
+
+	return clone, nil
+}
+
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		return isIdempotent(req.Method) || isDialError(err)
+	}
+
+	// the API rejected the create before acting on it, so even a POST is safe to send again
+	if isTooManyServers(res) {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// isTooManyServers reports whether the API rejected a server create because too many servers were
+// created recently, the body is peeked at and put back so the response can still be read
+func isTooManyServers(res *http.Response) bool {
+	if res == nil || !errorStatus(res.StatusCode) {
+		return false
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize+1))
+
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), res.Body), res.Body}
+
+	apiError := APIError{}
+
+	return json.Unmarshal(body, &apiError) == nil && strings.HasPrefix(apiError.Message, tooManyServersMessage)
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// isDialError reports whether the request failed before a connection to the API was established
+func isDialError(err error) bool {
+	opErr := &net.OpError{}
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
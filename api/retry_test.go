@@ -0,0 +1,421 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+// failingServer responds with the given failures before handing over to the success handler
+func failingServer(attempts *int32, failures []func(w http.ResponseWriter), success http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(atomic.AddInt32(attempts, 1)) - 1
+
+		if attempt < len(failures) {
+			failures[attempt](w)
+			return
+		}
+
+		success(w, r)
+	}))
+}
+
+func withStatus(status int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":      0,
+			"message": http.StatusText(status),
+		})
+	}
+}
+
+func withConnectionReset(w http.ResponseWriter) {
+	conn, _, _ := w.(http.Hijacker).Hijack()
+	conn.Close()
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := api.RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+
+	tests := map[string]struct {
+		failures     []func(w http.ResponseWriter)
+		do           func(client *api.Client) (interface{}, error)
+		wantErr      error
+		wantNetErr   bool
+		wantResponse interface{}
+		wantAttempts int32
+	}{
+		"when get is retried after server errors": {
+			failures: []func(w http.ResponseWriter){
+				withStatus(http.StatusBadGateway),
+				withStatus(http.StatusServiceUnavailable),
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.GetPublicKeys(context.Background())
+			},
+			wantResponse: api.PublicKeys{
+				{
+					Id:   json.Number("1"),
+					Name: "test",
+				},
+			},
+			wantAttempts: 3,
+		},
+		"when get is retried after connection reset": {
+			failures: []func(w http.ResponseWriter){
+				withConnectionReset,
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.GetPublicKeys(context.Background())
+			},
+			wantResponse: api.PublicKeys{
+				{
+					Id:   json.Number("1"),
+					Name: "test",
+				},
+			},
+			wantAttempts: 2,
+		},
+		"when retries are exhausted": {
+			failures: []func(w http.ResponseWriter){
+				withStatus(http.StatusBadGateway),
+				withStatus(http.StatusBadGateway),
+				withStatus(http.StatusBadGateway),
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.GetPublicKeys(context.Background())
+			},
+			wantErr: fmt.Errorf("error getting public keys: %w", api.APIError{
+				Message:    "Bad Gateway",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/account/publicKeys",
+				Body:       "{\"id\":0,\"message\":\"Bad Gateway\"}",
+			}),
+			wantResponse: api.PublicKeys(nil),
+			wantAttempts: 3,
+		},
+		"when post is not retried after server errors": {
+			failures: []func(w http.ResponseWriter){
+				withStatus(http.StatusBadGateway),
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.CreatePublicKey(context.Background(), api.CreatePublicKeyRequestBody{Name: "test"})
+			},
+			wantErr: fmt.Errorf("error creating public key: %w", api.APIError{
+				Message:    "Bad Gateway",
+				StatusCode: http.StatusBadGateway,
+				Method:     "POST",
+				Path:       "/account/publicKeys",
+				Body:       "{\"id\":0,\"message\":\"Bad Gateway\"}",
+			}),
+			wantResponse: (*api.PublicKey)(nil),
+			wantAttempts: 1,
+		},
+		"when post is not retried after connection reset": {
+			failures: []func(w http.ResponseWriter){
+				withConnectionReset,
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.CreatePublicKey(context.Background(), api.CreatePublicKeyRequestBody{Name: "test"})
+			},
+			wantNetErr:   true,
+			wantResponse: (*api.PublicKey)(nil),
+			wantAttempts: 1,
+		},
+		"when post is retried after being rate limited": {
+			failures: []func(w http.ResponseWriter){
+				withStatus(http.StatusTooManyRequests),
+			},
+			do: func(client *api.Client) (interface{}, error) {
+				return client.CreatePublicKey(context.Background(), api.CreatePublicKeyRequestBody{Name: "test"})
+			},
+			wantResponse: &api.PublicKey{
+				Id:   json.Number("1"),
+				Name: "test",
+			},
+			wantAttempts: 2,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			server := failingServer(&attempts, test.failures, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "POST" {
+					body := api.CreatePublicKeyRequestBody{}
+
+					// the body has to be replayed on every attempt
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"id":   1,
+						"name": body.Name,
+					})
+
+					return
+				}
+
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+					{
+						"id":   1,
+						"name": "test",
+					},
+				})
+			})
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL, api.WithRetryPolicy(policy))
+
+			assert.Nil(t, err)
+
+			response, err := test.do(client)
+
+			if test.wantNetErr {
+				assert.Error(t, err)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, response)
+
+			assert.Equal(t, test.wantAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	tests := map[string]struct {
+		retryAfter string
+		maxBackoff time.Duration
+		minElapsed time.Duration
+		maxElapsed time.Duration
+	}{
+		"when retry after is within the max backoff": {
+			retryAfter: "1",
+			maxBackoff: 2 * time.Second,
+			minElapsed: time.Second,
+			maxElapsed: 2 * time.Second,
+		},
+		"when retry after is beyond the max backoff": {
+			retryAfter: "3600",
+			maxBackoff: 10 * time.Millisecond,
+			maxElapsed: time.Second,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			server := failingServer(&attempts, []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", test.retryAfter)
+					withStatus(http.StatusTooManyRequests)(w)
+				},
+			}, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL, api.WithRetryPolicy(api.RetryPolicy{
+				MaxRetries: 1,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: test.maxBackoff,
+			}))
+
+			assert.Nil(t, err)
+
+			start := time.Now()
+
+			err = client.DeletePublicKey(context.Background(), 1)
+
+			elapsed := time.Since(start)
+
+			assert.Nil(t, err)
+
+			assert.GreaterOrEqual(t, elapsed, test.minElapsed)
+
+			assert.Less(t, elapsed, test.maxElapsed)
+
+			assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestRetryPolicyTooManyServers(t *testing.T) {
+	tooManyServers := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":      0,
+			"message": "You are creating too many servers in too short of a timespan. Please wait a while and try again a bit later.",
+		})
+	}
+
+	tests := map[string]struct {
+		failures     []func(w http.ResponseWriter)
+		timeout      time.Duration
+		wantErr      error
+		wantResponse *api.Server
+		wantAttempts int32
+		minElapsed   time.Duration
+	}{
+		"when create server is retried after too many servers error": {
+			failures: []func(w http.ResponseWriter){
+				tooManyServers,
+				tooManyServers,
+			},
+			timeout: time.Minute,
+			wantResponse: &api.Server{
+				Slug: "test",
+			},
+			wantAttempts: 3,
+			// equal jitter waits at least half of the 100ms and 200ms backoffs
+			minElapsed: 150 * time.Millisecond,
+		},
+		"when create server is not retried after other bad requests": {
+			failures: []func(w http.ResponseWriter){
+				withStatus(http.StatusBadRequest),
+			},
+			timeout: time.Minute,
+			wantErr: fmt.Errorf("error creating server: %w", api.APIError{
+				Message:    "Bad Request",
+				StatusCode: http.StatusBadRequest,
+				Method:     "POST",
+				Path:       "/servers",
+				Body:       "{\"id\":0,\"message\":\"Bad Request\"}",
+			}),
+			wantAttempts: 1,
+		},
+		"when the backoff would outlast the deadline": {
+			failures: []func(w http.ResponseWriter){
+				tooManyServers,
+			},
+			timeout: 40 * time.Millisecond,
+			wantErr: fmt.Errorf("error creating server: %w", api.APIError{
+				Message:    "You are creating too many servers in too short of a timespan. Please wait a while and try again a bit later.",
+				StatusCode: http.StatusBadRequest,
+				Method:     "POST",
+				Path:       "/servers",
+				Body:       "{\"id\":0,\"message\":\"You are creating too many servers in too short of a timespan. Please wait a while and try again a bit later.\"}",
+			}),
+			wantAttempts: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			server := failingServer(&attempts, test.failures, func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"slug": "test",
+				})
+			})
+
+			defer server.Close()
+
+			client, err := api.NewClient(server.URL, api.WithRetryPolicy(api.RetryPolicy{
+				MaxRetries:            2,
+				MinBackoff:            time.Millisecond,
+				MaxBackoff:            time.Millisecond,
+				TooManyServersBackoff: 100 * time.Millisecond,
+			}))
+
+			assert.Nil(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), test.timeout)
+
+			defer cancel()
+
+			start := time.Now()
+
+			response, err := client.CreateServer(ctx, api.CreateServerRequestBody{Name: "test"})
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, response)
+
+			assert.Equal(t, test.wantAttempts, atomic.LoadInt32(&attempts))
+
+			assert.GreaterOrEqual(t, time.Since(start), test.minElapsed)
+		})
+	}
+}
+
+type dialFailingDoer struct {
+	failures int
+	attempts int
+	doer     api.HttpRequestDoer
+}
+
+func (d *dialFailingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.attempts++
+
+	if d.attempts <= d.failures {
+		_, _ = io.Copy(io.Discard, req.Body)
+
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+
+	return d.doer.Do(req)
+}
+
+func TestRetryDoerRetriesPostOnDialErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := api.CreatePublicKeyRequestBody{}
+
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":   1,
+			"name": body.Name,
+		})
+	}))
+
+	defer server.Close()
+
+	doer := &dialFailingDoer{
+		failures: 2,
+		doer:     http.DefaultClient,
+	}
+
+	client, err := api.NewClient(server.URL, func(c *api.Client) error {
+		c.Client = api.NewRetryDoer(doer, api.RetryPolicy{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		})
+
+		return nil
+	})
+
+	assert.Nil(t, err)
+
+	publicKey, err := client.CreatePublicKey(context.Background(), api.CreatePublicKeyRequestBody{Name: "test"})
+
+	assert.Nil(t, err)
+
+	assert.Equal(t, &api.PublicKey{Id: json.Number("1"), Name: "test"}, publicKey)
+
+	assert.Equal(t, 3, doer.attempts)
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Server domain model
+type ServerDomainRequestBody struct {
+	// Domain name pointing to the server
+	Domain string `json:"domain"`
+}
+
+// SetServerMainDomain makes domain, which must already be one of the server's aliases, the server's main domain
+func (c *Client) SetServerMainDomain(ctx context.Context, serverSlug string, domain string) (string, error) {
+	path := fmt.Sprintf("servers/%s/mainDomain", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, &ServerDomainRequestBody{Domain: domain}, "error setting server main domain")
+}
+
+func (c *Client) AddServerAlias(ctx context.Context, serverSlug string, domain string) (string, error) {
+	path := fmt.Sprintf("servers/%s/aliases", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, &ServerDomainRequestBody{Domain: domain}, "error adding server alias")
+}
+
+func (c *Client) RemoveServerAlias(ctx context.Context, serverSlug string, domain string) (string, error) {
+	path := fmt.Sprintf("servers/%s/aliases/%s", url.PathEscape(serverSlug), url.PathEscape(domain))
+
+	return c.callbackAction(ctx, "DELETE", path, nil, "error removing server alias")
+}
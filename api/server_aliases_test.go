@@ -0,0 +1,111 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestServerDomainActions(t *testing.T) {
+	actions := map[string]struct {
+		method     string
+		path       string
+		errMessage string
+		wantBody   string
+		call       func(client *api.Client, ctx context.Context, serverSlug string, domain string) (string, error)
+	}{
+		"set main domain": {
+			method:     "POST",
+			path:       "/servers/%s/mainDomain",
+			errMessage: "error setting server main domain",
+			wantBody:   "{\"domain\":\"%s\"}",
+			call:       (*api.Client).SetServerMainDomain,
+		},
+		"add alias": {
+			method:     "POST",
+			path:       "/servers/%s/aliases",
+			errMessage: "error adding server alias",
+			wantBody:   "{\"domain\":\"%s\"}",
+			call:       (*api.Client).AddServerAlias,
+		},
+		"remove alias": {
+			method:     "DELETE",
+			path:       "/servers/%s/aliases/example.com",
+			errMessage: "error removing server alias",
+			call:       (*api.Client).RemoveServerAlias,
+		},
+	}
+
+	for action, actionTest := range actions {
+		tests := map[string]struct {
+			server       *httptest.Server
+			wantErr      error
+			ctx          context.Context
+			serverSlug   string
+			wantResponse string
+		}{
+			"when request errors": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"id":      1,
+						"message": "invalid domain",
+					})
+				})),
+				wantErr: fmt.Errorf("%s: %w", actionTest.errMessage, api.APIError{
+					ID:         1,
+					Message:    "invalid domain",
+					StatusCode: http.StatusBadRequest,
+					Method:     actionTest.method,
+					Path:       fmt.Sprintf(actionTest.path, "server1"),
+					Body:       "{\"id\":1,\"message\":\"invalid domain\"}",
+				}),
+				ctx:        context.Background(),
+				serverSlug: "server1",
+			},
+			"when request is successful": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, actionTest.method, r.Method)
+					assert.Equal(t, fmt.Sprintf(actionTest.path, "server1"), r.URL.Path)
+
+					body := new(bytes.Buffer)
+
+					_, _ = body.ReadFrom(r.Body)
+
+					if actionTest.wantBody != "" {
+						assert.Equal(t, fmt.Sprintf(actionTest.wantBody, "example.com"), body.String())
+					} else {
+						assert.Empty(t, body.String())
+					}
+
+					w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+					w.WriteHeader(http.StatusAccepted)
+				})),
+				ctx:          context.Background(),
+				serverSlug:   "server1",
+				wantResponse: "esn0WghLJ3",
+			},
+		}
+
+		for name, test := range tests {
+			t.Run(fmt.Sprintf("%s %s", action, name), func(t *testing.T) {
+				client, err := api.NewClient(test.server.URL)
+
+				assert.Nil(t, err)
+
+				callbackID, err := actionTest.call(client, test.ctx, test.serverSlug, "example.com")
+
+				assert.Equal(t, test.wantErr, err)
+
+				assert.Equal(t, test.wantResponse, callbackID)
+			})
+		}
+	}
+}
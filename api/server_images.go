@@ -45,13 +45,7 @@ func (c *Client) GetServersImages(ctx context.Context) (ServerImages, error) {
 	}
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get server images error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting server images: %w", apiError)
+		return nil, fmt.Errorf("error getting server images: %w", newAPIError(res))
 	}
 
 	defer res.Body.Close()
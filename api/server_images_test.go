@@ -28,23 +28,27 @@ func TestGetServerImages(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error getting server images: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error getting server images: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/images",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding get server images error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error getting server images: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/images",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -52,13 +52,7 @@ func (c *Client) GetServersLocations(ctx context.Context) (ServerLocations, erro
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get server locations error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting server locations: %w", apiError)
+		return nil, fmt.Errorf("error getting server locations: %w", newAPIError(res))
 	}
 
 	locations := ServerLocations{}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MetricSampling model
+type MetricSampling struct {
+	// Time the sample was taken
+	Timestamp string `json:"timestamp,omitempty" mapstructure:"timestamp"`
+
+	// Sampled amount
+	Amount float64 `json:"amount" mapstructure:"amount"`
+}
+
+// UsageMetric model
+type UsageMetric struct {
+	// Latest sample
+	Latest MetricSampling `json:"latestUsageSampling"`
+
+	// Samples over the last day, only returned with historical metrics
+	Samplings []MetricSampling `json:"usageSamplings,omitempty"`
+
+	// Amount the server profile allows, 0 when not applicable
+	Allowed float64 `json:"allowed,omitempty"`
+}
+
+// NetworkMetric model
+type NetworkMetric struct {
+	// Total traffic this month
+	Total float64 `json:"total"`
+
+	// Traffic the server profile allows per month
+	Allowed float64 `json:"allowed,omitempty"`
+
+	// Latest ingress sample
+	LatestIngress MetricSampling `json:"latestIngressSampling"`
+
+	// Latest egress sample
+	LatestEgress MetricSampling `json:"latestEgressSampling"`
+
+	// Ingress samples over the last day, only returned with historical metrics
+	IngressSamplings []MetricSampling `json:"ingressSamplings,omitempty"`
+
+	// Egress samples over the last day, only returned with historical metrics
+	EgressSamplings []MetricSampling `json:"egressSamplings,omitempty"`
+}
+
+// ServerMetrics model
+type ServerMetrics struct {
+	// CPU usage in percent
+	CPU UsageMetric `json:"cpu"`
+
+	// Memory usage in MiB
+	Memory UsageMetric `json:"memory"`
+
+	// Disk usage in MiB
+	Disk UsageMetric `json:"disk"`
+
+	// Network traffic in MiB
+	Network NetworkMetric `json:"network"`
+}
+
+func (c *Client) GetServerMetrics(ctx context.Context, serverSlug string) (*ServerMetrics, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/metrics", serverSlug)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server metrics: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server metrics: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting server metrics: %w", newAPIError(res))
+	}
+
+	metrics := ServerMetrics{}
+
+	if err = json.NewDecoder(res.Body).Decode(&metrics); err != nil {
+		return nil, fmt.Errorf("error decoding get server metrics response body: %w", err)
+	}
+
+	return &metrics, nil
+}
+
+func (c *Client) GetServerMetricsNow(ctx context.Context, serverSlug string) (*ServerMetrics, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/metrics/now", serverSlug)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting current server metrics: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting current server metrics: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting current server metrics: %w", newAPIError(res))
+	}
+
+	metrics := ServerMetrics{}
+
+	if err = json.NewDecoder(res.Body).Decode(&metrics); err != nil {
+		return nil, fmt.Errorf("error decoding get current server metrics response body: %w", err)
+	}
+
+	return &metrics, nil
+}
@@ -0,0 +1,167 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetServerMetrics(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		serverSlug    string
+		wantResponse  *api.ServerMetrics
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "server not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting server metrics: %w", api.APIError{
+				ID:         1,
+				Message:    "server not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "GET",
+				Path:       "/servers//metrics",
+				Body:       "{\"id\":1,\"message\":\"server not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode([]int{1})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get server metrics response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/metrics", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"cpu": map[string]interface{}{
+						"latestUsageSampling": map[string]interface{}{"timestamp": "2022-10-25 05:11:34", "amount": 12.5},
+						"usageSamplings": []map[string]interface{}{
+							{"timestamp": "2022-10-25 05:11:34", "amount": 12.5},
+						},
+					},
+					"network": map[string]interface{}{
+						"total":   2048,
+						"allowed": 1048576,
+					},
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			wantResponse: &api.ServerMetrics{
+				CPU: api.UsageMetric{
+					Latest: api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 12.5},
+					Samplings: []api.MetricSampling{
+						{Timestamp: "2022-10-25 05:11:34", Amount: 12.5},
+					},
+				},
+				Network: api.NetworkMetric{
+					Total:   2048,
+					Allowed: 1048576,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			metrics, err := client.GetServerMetrics(test.ctx, test.serverSlug)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, metrics)
+		})
+	}
+}
+
+func TestGetServerMetricsNow(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		serverSlug   string
+		wantResponse *api.ServerMetrics
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "server not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting current server metrics: %w", api.APIError{
+				ID:         1,
+				Message:    "server not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "GET",
+				Path:       "/servers//metrics/now",
+				Body:       "{\"id\":1,\"message\":\"server not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/metrics/now", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"memory": map[string]interface{}{
+						"latestUsageSampling": map[string]interface{}{"timestamp": "2022-10-25 05:11:34", "amount": 512},
+						"allowed":             1024,
+					},
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			wantResponse: &api.ServerMetrics{
+				Memory: api.UsageMetric{
+					Latest:  api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 512},
+					Allowed: 1024,
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			metrics, err := client.GetServerMetricsNow(test.ctx, test.serverSlug)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, metrics)
+		})
+	}
+}
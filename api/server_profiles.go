@@ -77,13 +77,7 @@ func (c *Client) GetServersProfiles(ctx context.Context, params GetServersProfil
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get server profiles error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting server profiles: %w", apiError)
+		return nil, fmt.Errorf("error getting server profiles: %w", newAPIError(res))
 	}
 
 	profiles := ServerProfiles{}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ServerScript model
+type ServerScript struct {
+	// Server script ID
+	ID json.Number `json:"id,omitempty" mapstructure:"id"`
+
+	// Server script name
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Path the script was deployed to on the server
+	Path string `json:"path,omitempty" mapstructure:"path"`
+
+	// Date/time the script was last run
+	LastRun string `json:"lastRun,omitempty" mapstructure:"last_run"`
+
+	// Callback ID of the last run
+	LastRunCallbackID string `json:"lastRunCallbackId,omitempty" mapstructure:"last_run_callback_id"`
+
+	// Server script creation date/time
+	Created string `json:"created,omitempty" mapstructure:"created_at"`
+
+	CallbackID string `json:"-" mapstructure:"-"`
+}
+
+// ServerScripts is a collection of ServerScript
+type ServerScripts []ServerScript
+
+// Create server script model
+type CreateServerScriptRequestBody struct {
+	// ID of the account script to deploy
+	ScriptID int64 `json:"scriptId"`
+
+	// Path to deploy the script to on the server
+	Path string `json:"path"`
+
+	// Whether the deployed script should be made executable
+	MakeScriptExecutable bool `json:"makeScriptExecutable"`
+
+	// Whether the script should be run right after being deployed
+	ExecuteImmediately bool `json:"executeImmediately"`
+}
+
+func (c *Client) GetServerScripts(ctx context.Context, serverSlug string) (ServerScripts, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/scripts", serverSlug)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server scripts: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server scripts: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting server scripts: %w", newAPIError(res))
+	}
+
+	serverScripts := ServerScripts{}
+
+	if err = json.NewDecoder(res.Body).Decode(&serverScripts); err != nil {
+		return nil, fmt.Errorf("error decoding get server scripts response body: %w", err)
+	}
+
+	return serverScripts, nil
+}
+
+func (c *Client) CreateServerScript(ctx context.Context, serverSlug string, body CreateServerScriptRequestBody) (*ServerScript, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/scripts", serverSlug)
+
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error creating server script: %w", newAPIError(res))
+	}
+
+	serverScript := ServerScript{}
+
+	if err = json.NewDecoder(res.Body).Decode(&serverScript); err != nil {
+		return nil, fmt.Errorf("error decoding create server script response body: %w", err)
+	}
+
+	serverScript.CallbackID = res.Header.Get("X-Callback-ID")
+
+	return &serverScript, nil
+}
+
+func (c *Client) DeleteServerScript(ctx context.Context, serverSlug string, serverScriptID int64) (string, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/scripts/%d", serverSlug, serverScriptID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", serverURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return "", fmt.Errorf("error deleting server script: %w", newAPIError(res))
+	}
+
+	return res.Header.Get("X-Callback-ID"), nil
+}
@@ -0,0 +1,242 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetServerScripts(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		serverSlug    string
+		wantResponse  api.ServerScripts
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting server scripts: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/servers//scripts",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id": 1,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get server scripts response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/scripts", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+					{
+						"id":                1,
+						"name":              "bootstrap",
+						"path":              "/root/bootstrap.sh",
+						"lastRun":           "25/10/2022 05:11:34",
+						"lastRunCallbackId": "esn0WghLJ3",
+						"created":           "24/10/2022 05:11:34",
+					},
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			wantResponse: api.ServerScripts{
+				{
+					ID:                json.Number("1"),
+					Name:              "bootstrap",
+					Path:              "/root/bootstrap.sh",
+					LastRun:           "25/10/2022 05:11:34",
+					LastRunCallbackID: "esn0WghLJ3",
+					Created:           "24/10/2022 05:11:34",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			serverScripts, err := client.GetServerScripts(test.ctx, test.serverSlug)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, serverScripts)
+		})
+	}
+}
+
+func TestCreateServerScript(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		serverSlug   string
+		req          api.CreateServerScriptRequestBody
+		wantResponse *api.ServerScript
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error creating server script: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "POST",
+				Path:       "/servers//scripts",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/servers/server1/scripts", r.URL.Path)
+
+				body := api.CreateServerScriptRequestBody{}
+
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, api.CreateServerScriptRequestBody{
+					ScriptID:             1,
+					Path:                 "/root/bootstrap.sh",
+					MakeScriptExecutable: true,
+					ExecuteImmediately:   true,
+				}, body)
+
+				w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+				w.WriteHeader(http.StatusAccepted)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":   2,
+					"name": "bootstrap",
+					"path": body.Path,
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			req: api.CreateServerScriptRequestBody{
+				ScriptID:             1,
+				Path:                 "/root/bootstrap.sh",
+				MakeScriptExecutable: true,
+				ExecuteImmediately:   true,
+			},
+			wantResponse: &api.ServerScript{
+				ID:         json.Number("2"),
+				Name:       "bootstrap",
+				Path:       "/root/bootstrap.sh",
+				CallbackID: "esn0WghLJ3",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			serverScript, err := client.CreateServerScript(test.ctx, test.serverSlug, test.req)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, serverScript)
+		})
+	}
+}
+
+func TestDeleteServerScript(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		serverSlug   string
+		id           int64
+		wantResponse string
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "script not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error deleting server script: %w", api.APIError{
+				ID:         1,
+				Message:    "script not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/servers//scripts/0",
+				Body:       "{\"id\":1,\"message\":\"script not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/scripts/1", r.URL.Path)
+
+				w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+				w.WriteHeader(http.StatusAccepted)
+			})),
+			ctx:          context.Background(),
+			serverSlug:   "server1",
+			id:           1,
+			wantResponse: "esn0WghLJ3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			callbackID, err := client.DeleteServerScript(test.ctx, test.serverSlug, test.id)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, callbackID)
+		})
+	}
+}
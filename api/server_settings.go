@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Server setting toggle model
+type ServerToggleRequestBody struct {
+	// Whether the setting is turned on
+	Enabled bool `json:"enabled"`
+}
+
+// Server web server model
+type ServerWebServerRequestBody struct {
+	// Web server type (apache, nginx, none)
+	WebServer string `json:"webServer"`
+}
+
+// Server PHP version model
+type ServerPhpVersionRequestBody struct {
+	// PHP version. For example &quot;8.1&quot;
+	PhpVersion string `json:"phpVersion"`
+}
+
+func (c *Client) SetServerSSHPasswordAuth(ctx context.Context, serverSlug string, enabled bool) (string, error) {
+	path := fmt.Sprintf("servers/%s/sshPasswordAuth", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, ServerToggleRequestBody{Enabled: enabled}, "error setting server ssh password authentication")
+}
+
+func (c *Client) SetServerWordPressLockdown(ctx context.Context, serverSlug string, enabled bool) (string, error) {
+	path := fmt.Sprintf("servers/%s/wordpressLockdown", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, ServerToggleRequestBody{Enabled: enabled}, "error setting server wordpress lockdown")
+}
+
+// SetServerWebServer switches the web server of the server without reinstalling it
+func (c *Client) SetServerWebServer(ctx context.Context, serverSlug string, webServer string) (string, error) {
+	path := fmt.Sprintf("servers/%s/webServer", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, ServerWebServerRequestBody{WebServer: webServer}, "error setting server web server")
+}
+
+// SetServerPhpVersion switches the PHP version of the server without reinstalling it
+func (c *Client) SetServerPhpVersion(ctx context.Context, serverSlug string, phpVersion string) (string, error) {
+	path := fmt.Sprintf("servers/%s/phpVersion", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, ServerPhpVersionRequestBody{PhpVersion: phpVersion}, "error setting server php version")
+}
@@ -0,0 +1,197 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestServerToggleActions(t *testing.T) {
+	actions := map[string]struct {
+		path       string
+		errMessage string
+		call       func(client *api.Client, ctx context.Context, serverSlug string, enabled bool) (string, error)
+	}{
+		"ssh password auth": {
+			path:       "/servers/%s/sshPasswordAuth",
+			errMessage: "error setting server ssh password authentication",
+			call:       (*api.Client).SetServerSSHPasswordAuth,
+		},
+		"wordpress lockdown": {
+			path:       "/servers/%s/wordpressLockdown",
+			errMessage: "error setting server wordpress lockdown",
+			call:       (*api.Client).SetServerWordPressLockdown,
+		},
+	}
+
+	for action, actionTest := range actions {
+		tests := map[string]struct {
+			server       *httptest.Server
+			wantErr      error
+			ctx          context.Context
+			serverSlug   string
+			enabled      bool
+			wantResponse string
+		}{
+			"when request errors": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"id":      1,
+						"message": "unauthorized request",
+					})
+				})),
+				wantErr: fmt.Errorf("%s: %w", actionTest.errMessage, api.APIError{
+					ID:         1,
+					Message:    "unauthorized request",
+					StatusCode: http.StatusUnauthorized,
+					Method:     "POST",
+					Path:       fmt.Sprintf(actionTest.path, ""),
+					Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+				}),
+				ctx: context.Background(),
+			},
+			"when disabling is successful": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					body := map[string]interface{}{}
+
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+					assert.Equal(t, map[string]interface{}{"enabled": false}, body)
+
+					w.Header().Add("X-Callback-ID", "disabled")
+					w.WriteHeader(http.StatusAccepted)
+				})),
+				ctx:          context.Background(),
+				serverSlug:   "server1",
+				wantResponse: "disabled",
+			},
+			"when enabling is successful": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "POST", r.Method)
+					assert.Equal(t, fmt.Sprintf(actionTest.path, "server1"), r.URL.Path)
+
+					body := api.ServerToggleRequestBody{}
+
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+					assert.True(t, body.Enabled)
+
+					w.Header().Add("X-Callback-ID", "enabled")
+					w.WriteHeader(http.StatusAccepted)
+				})),
+				ctx:          context.Background(),
+				serverSlug:   "server1",
+				enabled:      true,
+				wantResponse: "enabled",
+			},
+		}
+
+		for name, test := range tests {
+			t.Run(fmt.Sprintf("%s %s", action, name), func(t *testing.T) {
+				client, err := api.NewClient(test.server.URL)
+
+				assert.Nil(t, err)
+
+				callbackID, err := actionTest.call(client, test.ctx, test.serverSlug, test.enabled)
+
+				assert.Equal(t, test.wantErr, err)
+
+				assert.Equal(t, test.wantResponse, callbackID)
+			})
+		}
+	}
+}
+
+func TestServerStackActions(t *testing.T) {
+	actions := map[string]struct {
+		path       string
+		errMessage string
+		value      string
+		wantBody   map[string]interface{}
+		call       func(client *api.Client, ctx context.Context, serverSlug string, value string) (string, error)
+	}{
+		"web server": {
+			path:       "/servers/%s/webServer",
+			errMessage: "error setting server web server",
+			value:      "nginx",
+			wantBody:   map[string]interface{}{"webServer": "nginx"},
+			call:       (*api.Client).SetServerWebServer,
+		},
+		"php version": {
+			path:       "/servers/%s/phpVersion",
+			errMessage: "error setting server php version",
+			value:      "8.1",
+			wantBody:   map[string]interface{}{"phpVersion": "8.1"},
+			call:       (*api.Client).SetServerPhpVersion,
+		},
+	}
+
+	for action, actionTest := range actions {
+		tests := map[string]struct {
+			server       *httptest.Server
+			wantErr      error
+			ctx          context.Context
+			serverSlug   string
+			wantResponse string
+		}{
+			"when request errors": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"id":      1,
+						"message": "unsupported value",
+					})
+				})),
+				wantErr: fmt.Errorf("%s: %w", actionTest.errMessage, api.APIError{
+					ID:         1,
+					Message:    "unsupported value",
+					StatusCode: http.StatusBadRequest,
+					Method:     "POST",
+					Path:       fmt.Sprintf(actionTest.path, "server1"),
+					Body:       "{\"id\":1,\"message\":\"unsupported value\"}",
+				}),
+				ctx:        context.Background(),
+				serverSlug: "server1",
+			},
+			"when request is successful": {
+				server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "POST", r.Method)
+					assert.Equal(t, fmt.Sprintf(actionTest.path, "server1"), r.URL.Path)
+
+					body := map[string]interface{}{}
+
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+					assert.Equal(t, actionTest.wantBody, body)
+
+					w.Header().Add("X-Callback-ID", "switched")
+					w.WriteHeader(http.StatusAccepted)
+				})),
+				ctx:          context.Background(),
+				serverSlug:   "server1",
+				wantResponse: "switched",
+			},
+		}
+
+		for name, test := range tests {
+			t.Run(fmt.Sprintf("%s %s", action, name), func(t *testing.T) {
+				client, err := api.NewClient(test.server.URL)
+
+				assert.Nil(t, err)
+
+				callbackID, err := actionTest.call(client, test.ctx, test.serverSlug, actionTest.value)
+
+				assert.Equal(t, test.wantErr, err)
+
+				assert.Equal(t, test.wantResponse, callbackID)
+			})
+		}
+	}
+}
@@ -22,6 +22,15 @@ type CreateShellUserRequestBody struct {
 	PublicKeys []int  `json:"publicKeys,omitempty"`
 }
 
+// UpdateShellUserPublicKeysRequestBody always sends publicKeys so that all keys can be removed with an empty list
+type UpdateShellUserPublicKeysRequestBody struct {
+	PublicKeys []int `json:"publicKeys"`
+}
+
+type UpdateShellUserPasswordRequestBody struct {
+	Password string `json:"password"`
+}
+
 type ShellUser struct {
 	ID         json.Number `json:"id,omitempty" mapstructure:"id"`
 	Username   string      `json:"username,omitempty" mapstructure:"username"`
@@ -56,13 +65,7 @@ func (c *Client) GetShellUsers(ctx context.Context, serverSlug string) (ShellUse
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get server shell users error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("%s: %w", errGettingServerShellUsers, apiError)
+		return nil, fmt.Errorf("%s: %w", errGettingServerShellUsers, newAPIError(res))
 	}
 
 	shellUsers := ShellUsers{}
@@ -91,7 +94,7 @@ func (c *Client) CreateShellUser(ctx context.Context, serverSlug string, createS
 
 	serverURL.Path += fmt.Sprintf("servers/%s/shellUsers", serverSlug)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", serverURL.String(), bodyReader)
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -104,13 +107,7 @@ func (c *Client) CreateShellUser(ctx context.Context, serverSlug string, createS
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding create shell user error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error creating shell user: %w", apiError)
+		return nil, fmt.Errorf("error creating shell user: %w", newAPIError(res))
 	}
 
 	shellUser := ShellUser{}
@@ -145,13 +142,7 @@ func (c *Client) DeleteShellUser(ctx context.Context, serverSlug string, shellUs
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return "", fmt.Errorf("error decoding delete shell user error response body: %w", err)
-		}
-
-		return "", fmt.Errorf("error deleting server shell user: %w", apiError)
+		return "", fmt.Errorf("error deleting server shell user: %w", newAPIError(res))
 	}
 
 	return res.Header.Get("X-Callback-ID"), nil
@@ -160,7 +151,11 @@ func (c *Client) DeleteShellUser(ctx context.Context, serverSlug string, shellUs
 func (c *Client) UpdateShellUserPublicKeys(ctx context.Context, serverSlug string, shellUserID int64, publicKeys []int) (*ShellUser, error) {
 	var bodyReader io.Reader
 
-	shellUserBody := &CreateShellUserRequestBody{
+	if publicKeys == nil {
+		publicKeys = []int{}
+	}
+
+	shellUserBody := &UpdateShellUserPublicKeysRequestBody{
 		PublicKeys: publicKeys,
 	}
 
@@ -191,19 +186,59 @@ func (c *Client) UpdateShellUserPublicKeys(ctx context.Context, serverSlug strin
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
+		return nil, fmt.Errorf("error updating shell user: %w", newAPIError(res))
+	}
 
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding update shell user error response body: %w", err)
-		}
+	shellUser := ShellUser{}
 
-		return nil, fmt.Errorf("error updating shell user: %w", apiError)
+	if err = json.NewDecoder(res.Body).Decode(&shellUser); err != nil {
+		return nil, fmt.Errorf("error decoding update shell user response body: %w", err)
+	}
+
+	shellUser.CallbackID = res.Header.Get("X-Callback-ID")
+
+	return &shellUser, nil
+}
+
+func (c *Client) UpdateShellUserPassword(ctx context.Context, serverSlug string, shellUserID int64, password string) (*ShellUser, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(UpdateShellUserPasswordRequestBody{
+		Password: password,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/shellUsers/%d", url.PathEscape(serverSlug), shellUserID)
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error updating shell user password: %w", newAPIError(res))
 	}
 
 	shellUser := ShellUser{}
 
 	if err = json.NewDecoder(res.Body).Decode(&shellUser); err != nil {
-		return nil, fmt.Errorf("error decoding update shell user response body: %w", err)
+		return nil, fmt.Errorf("error decoding update shell user password response body: %w", err)
 	}
 
 	shellUser.CallbackID = res.Header.Get("X-Callback-ID")
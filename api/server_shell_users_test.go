@@ -29,23 +29,27 @@ func TestGetShellUsers(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error getting server shell users: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error getting server shell users: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/servers//shellUsers",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding get server shell users error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error getting server shell users: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/servers//shellUsers",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -153,23 +157,27 @@ func TestCreateShellUser(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error creating shell user: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error creating shell user: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "POST",
+				Path:       "/servers//shellUsers",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding create shell user error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error creating shell user: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "POST",
+				Path:       "/servers//shellUsers",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -264,23 +272,27 @@ func TestDeleteShellUser(t *testing.T) {
 					"message": "server shell user not found",
 				})
 			})),
-			wantErr: fmt.Errorf("error deleting server shell user: %w", api.APIError{ID: 1, Message: "server shell user not found"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error deleting server shell user: %w", api.APIError{
+				ID:         1,
+				Message:    "server shell user not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/servers//shellUsers/0",
+				Body:       "{\"id\":1,\"message\":\"server shell user not found\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding delete shell user error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error deleting server shell user: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "DELETE",
+				Path:       "/servers//shellUsers/0",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -328,23 +340,27 @@ func TestUpdateShellUserPublicKeys(t *testing.T) {
 					"message": "unauthorized request",
 				})
 			})),
-			wantErr: fmt.Errorf("error updating shell user: %w", api.APIError{ID: 1, Message: "unauthorized request"}),
-			ctx:     context.Background(),
+			wantErr: fmt.Errorf("error updating shell user: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "PATCH",
+				Path:       "/servers//shellUsers/0",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
 		},
-		"when error decoding error response": {
+		"when error response is not json": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusUnauthorized)
-				_ = json.NewEncoder(w).Encode(map[string]interface{}{
-					"id":      "1",
-					"message": "unexpected error response",
-				})
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
 			})),
-			wantErr: fmt.Errorf("error decoding update shell user error response body: %w", &json.UnmarshalTypeError{
-				Field:  "id",
-				Struct: "APIError",
-				Type:   reflect.TypeOf(1),
-				Value:  "string",
-				Offset: 9,
+			wantErr: fmt.Errorf("error updating shell user: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "PATCH",
+				Path:       "/servers//shellUsers/0",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
 			}),
 			ctx: context.Background(),
 		},
@@ -363,6 +379,25 @@ func TestUpdateShellUserPublicKeys(t *testing.T) {
 				Offset: 10,
 			}),
 		},
+		"when all public keys are removed": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]interface{}{}
+
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				assert.Equal(t, map[string]interface{}{"publicKeys": []interface{}{}}, body)
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"username":   "xula",
+					"publicKeys": []map[string]interface{}{},
+				})
+			})),
+			ctx: context.Background(),
+			wantResponse: &api.ShellUser{
+				Username:   "xula",
+				PublicKeys: api.PublicKeys{},
+			},
+		},
 		"when request is successful": {
 			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				shellUser := api.CreateShellUserRequestBody{}
@@ -415,3 +450,100 @@ func TestUpdateShellUserPublicKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateShellUserPassword(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		serverSlug    string
+		shellUserID   int64
+		password      string
+		wantResponse  *api.ShellUser
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "password is too short",
+				})
+			})),
+			wantErr: fmt.Errorf("error updating shell user password: %w", api.APIError{
+				ID:         1,
+				Message:    "password is too short",
+				StatusCode: http.StatusBadRequest,
+				Method:     "PATCH",
+				Path:       "/servers//shellUsers/0",
+				Body:       "{\"id\":1,\"message\":\"password is too short\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id": true,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding update shell user password response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "PATCH", r.Method)
+				assert.Equal(t, "/servers/test/shellUsers/1", r.URL.Path)
+
+				body := api.UpdateShellUserPasswordRequestBody{}
+
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+				assert.Equal(t, "rotated", body.Password)
+
+				w.Header().Set("X-Callback-ID", "callback")
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":       1,
+					"username": "xula",
+					"group":    "sudo",
+					"shell":    "/bin/bash",
+					"created":  "27/07/2022 11:29:22",
+				})
+			})),
+			ctx:         context.Background(),
+			serverSlug:  "test",
+			shellUserID: 1,
+			password:    "rotated",
+			wantResponse: &api.ShellUser{
+				ID:         json.Number("1"),
+				Username:   "xula",
+				Group:      "sudo",
+				Shell:      "/bin/bash",
+				Created:    "27/07/2022 11:29:22",
+				CallbackID: "callback",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			shellUser, err := client.UpdateShellUserPassword(test.ctx, test.serverSlug, test.shellUserID, test.password)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, shellUser)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Snapshot model
+type Snapshot struct {
+	// Snapshot ID
+	ID json.Number `json:"id,omitempty" mapstructure:"id"`
+
+	// Snapshot name
+	Name string `json:"name,omitempty" mapstructure:"name"`
+
+	// Snapshot creation date/time
+	Date string `json:"date,omitempty" mapstructure:"created_at"`
+
+	// Snapshot type (daily, weekly, monthly or user)
+	Type string `json:"type,omitempty" mapstructure:"type"`
+
+	// Virtualization type of the server the snapshot was taken from
+	Virtualization string `json:"virtualization,omitempty" mapstructure:"virtualization"`
+
+	// Whether the snapshot has finished being taken
+	Completed bool `json:"completed,omitempty" mapstructure:"completed"`
+
+	// Whether the snapshot can be deleted
+	Deletable bool `json:"deletable,omitempty" mapstructure:"deletable"`
+
+	CallbackID string `json:"-" mapstructure:"-"`
+}
+
+// Snapshots is a collection of Snapshot
+type Snapshots []Snapshot
+
+// Create snapshot model
+type CreateSnapshotRequestBody struct {
+	// Snapshot name
+	Name string `json:"name"`
+}
+
+// Restore snapshot model
+type RestoreSnapshotRequestBody struct {
+	// ID of the snapshot to restore the server from
+	SnapshotID int64 `json:"snapshotId"`
+}
+
+func (c *Client) GetServerSnapshots(ctx context.Context, serverSlug string) (Snapshots, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/snapshots", serverSlug)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", serverURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server snapshots: %w", err)
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server snapshots: %w", err)
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error getting server snapshots: %w", newAPIError(res))
+	}
+
+	snapshots := Snapshots{}
+
+	if err = json.NewDecoder(res.Body).Decode(&snapshots); err != nil {
+		return nil, fmt.Errorf("error decoding get server snapshots response body: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+func (c *Client) CreateServerSnapshot(ctx context.Context, serverSlug string, body CreateSnapshotRequestBody) (*Snapshot, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/snapshots", serverSlug)
+
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return nil, fmt.Errorf("error creating server snapshot: %w", newAPIError(res))
+	}
+
+	snapshot := Snapshot{}
+
+	if err = json.NewDecoder(res.Body).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding create server snapshot response body: %w", err)
+	}
+
+	snapshot.CallbackID = res.Header.Get("X-Callback-ID")
+
+	return &snapshot, nil
+}
+
+func (c *Client) DeleteServerSnapshot(ctx context.Context, serverSlug string, snapshotID int64) (string, error) {
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/snapshots/%d", serverSlug, snapshotID)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", serverURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return "", fmt.Errorf("error deleting server snapshot: %w", newAPIError(res))
+	}
+
+	return res.Header.Get("X-Callback-ID"), nil
+}
+
+func (c *Client) RestoreServerSnapshot(ctx context.Context, serverSlug string, body RestoreSnapshotRequestBody) (string, error) {
+	var bodyReader io.Reader
+
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	bodyReader = bytes.NewReader(buf)
+
+	serverSlug = url.PathEscape(serverSlug)
+
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL.Path += fmt.Sprintf("servers/%s/actions/restore", serverSlug)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", serverURL.String(), bodyReader)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	defer res.Body.Close()
+
+	if errorStatus(res.StatusCode) {
+		return "", fmt.Errorf("error restoring server snapshot: %w", newAPIError(res))
+	}
+
+	return res.Header.Get("X-Callback-ID"), nil
+}
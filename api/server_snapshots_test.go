@@ -0,0 +1,393 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+)
+
+func TestGetServerSnapshots(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		serverSlug    string
+		wantResponse  api.Snapshots
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error getting server snapshots: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "GET",
+				Path:       "/servers//snapshots",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error response is not json": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
+			})),
+			wantErr: fmt.Errorf("error getting server snapshots: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "GET",
+				Path:       "/servers//snapshots",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id": 1,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding get server snapshots response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/snapshots", r.URL.Path)
+
+				_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+					{
+						"id":             1,
+						"name":           "before upgrade",
+						"date":           "25/10/2022 05:11:34",
+						"type":           "user",
+						"virtualization": "container",
+						"completed":      true,
+						"deletable":      true,
+					},
+					{
+						"id":             2,
+						"name":           "daily",
+						"date":           "26/10/2022 05:11:34",
+						"type":           "daily",
+						"virtualization": "container",
+						"completed":      false,
+						"deletable":      false,
+					},
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			wantResponse: api.Snapshots{
+				{
+					ID:             json.Number("1"),
+					Name:           "before upgrade",
+					Date:           "25/10/2022 05:11:34",
+					Type:           "user",
+					Virtualization: "container",
+					Completed:      true,
+					Deletable:      true,
+				},
+				{
+					ID:             json.Number("2"),
+					Name:           "daily",
+					Date:           "26/10/2022 05:11:34",
+					Type:           "daily",
+					Virtualization: "container",
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			snapshots, err := client.GetServerSnapshots(test.ctx, test.serverSlug)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, snapshots)
+		})
+	}
+}
+
+func TestCreateServerSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		server        *httptest.Server
+		wantErr       error
+		wantDecodeErr string
+		ctx           context.Context
+		serverSlug    string
+		req           api.CreateSnapshotRequestBody
+		wantResponse  *api.Snapshot
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "unauthorized request",
+				})
+			})),
+			wantErr: fmt.Errorf("error creating server snapshot: %w", api.APIError{
+				ID:         1,
+				Message:    "unauthorized request",
+				StatusCode: http.StatusUnauthorized,
+				Method:     "POST",
+				Path:       "/servers//snapshots",
+				Body:       "{\"id\":1,\"message\":\"unauthorized request\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error response is not json": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
+			})),
+			wantErr: fmt.Errorf("error creating server snapshot: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "POST",
+				Path:       "/servers//snapshots",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
+			}),
+			ctx: context.Background(),
+		},
+		"when error decoding response": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"name": true,
+				})
+			})),
+			ctx:           context.Background(),
+			wantDecodeErr: "error decoding create server snapshot response body",
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/snapshots", r.URL.Path)
+
+				snapshot := api.CreateSnapshotRequestBody{}
+
+				_ = json.NewDecoder(r.Body).Decode(&snapshot)
+
+				w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":             1,
+					"name":           snapshot.Name,
+					"date":           "25/10/2022 05:11:34",
+					"type":           "user",
+					"virtualization": "kvm",
+					"completed":      false,
+					"deletable":      true,
+				})
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			req: api.CreateSnapshotRequestBody{
+				Name: "before upgrade",
+			},
+			wantResponse: &api.Snapshot{
+				ID:             json.Number("1"),
+				Name:           "before upgrade",
+				Date:           "25/10/2022 05:11:34",
+				Type:           "user",
+				Virtualization: "kvm",
+				Deletable:      true,
+				CallbackID:     "esn0WghLJ3",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			snapshot, err := client.CreateServerSnapshot(test.ctx, test.serverSlug, test.req)
+
+			if test.wantDecodeErr != "" {
+				var typeErr *json.UnmarshalTypeError
+
+				assert.ErrorAs(t, err, &typeErr)
+
+				assert.ErrorContains(t, err, test.wantDecodeErr)
+			} else {
+				assert.Equal(t, test.wantErr, err)
+			}
+
+			assert.Equal(t, test.wantResponse, snapshot)
+		})
+	}
+}
+
+func TestDeleteServerSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		serverSlug   string
+		id           int64
+		wantResponse string
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "snapshot not found",
+				})
+			})),
+			wantErr: fmt.Errorf("error deleting server snapshot: %w", api.APIError{
+				ID:         1,
+				Message:    "snapshot not found",
+				StatusCode: http.StatusNotFound,
+				Method:     "DELETE",
+				Path:       "/servers//snapshots/0",
+				Body:       "{\"id\":1,\"message\":\"snapshot not found\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error response is not json": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
+			})),
+			wantErr: fmt.Errorf("error deleting server snapshot: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "DELETE",
+				Path:       "/servers//snapshots/0",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/snapshots/1", r.URL.Path)
+
+				w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+				w.WriteHeader(http.StatusAccepted)
+			})),
+			ctx:          context.Background(),
+			serverSlug:   "server1",
+			id:           1,
+			wantResponse: "esn0WghLJ3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			callbackID, err := client.DeleteServerSnapshot(test.ctx, test.serverSlug, test.id)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, callbackID)
+		})
+	}
+}
+
+func TestRestoreServerSnapshot(t *testing.T) {
+	tests := map[string]struct {
+		server       *httptest.Server
+		wantErr      error
+		ctx          context.Context
+		serverSlug   string
+		req          api.RestoreSnapshotRequestBody
+		wantResponse string
+	}{
+		"when request errors": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      1,
+					"message": "snapshot is not completed",
+				})
+			})),
+			wantErr: fmt.Errorf("error restoring server snapshot: %w", api.APIError{
+				ID:         1,
+				Message:    "snapshot is not completed",
+				StatusCode: http.StatusBadRequest,
+				Method:     "POST",
+				Path:       "/servers//actions/restore",
+				Body:       "{\"id\":1,\"message\":\"snapshot is not completed\"}",
+			}),
+			ctx: context.Background(),
+		},
+		"when error response is not json": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte("<html><body><h1>502 Bad Gateway</h1></body></html>\n"))
+			})),
+			wantErr: fmt.Errorf("error restoring server snapshot: %w", api.APIError{
+				Message:    "502 Bad Gateway: <html><body><h1>502 Bad Gateway</h1></body></html>",
+				StatusCode: http.StatusBadGateway,
+				Method:     "POST",
+				Path:       "/servers//actions/restore",
+				Body:       "<html><body><h1>502 Bad Gateway</h1></body></html>",
+			}),
+			ctx: context.Background(),
+		},
+		"when request is successful": {
+			server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/servers/server1/actions/restore", r.URL.Path)
+
+				body := api.RestoreSnapshotRequestBody{}
+
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				assert.Equal(t, int64(10), body.SnapshotID)
+
+				w.Header().Add("X-Callback-ID", "esn0WghLJ3")
+				w.WriteHeader(http.StatusAccepted)
+			})),
+			ctx:        context.Background(),
+			serverSlug: "server1",
+			req: api.RestoreSnapshotRequestBody{
+				SnapshotID: 10,
+			},
+			wantResponse: "esn0WghLJ3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, err := api.NewClient(test.server.URL)
+
+			assert.Nil(t, err)
+
+			callbackID, err := client.RestoreServerSnapshot(test.ctx, test.serverSlug, test.req)
+
+			assert.Equal(t, test.wantErr, err)
+
+			assert.Equal(t, test.wantResponse, callbackID)
+		})
+	}
+}
@@ -116,6 +116,9 @@ type Server struct {
 	// Webserver type
 	WebServer string `json:"webServer,omitempty" mapstructure:"webserver"`
 
+	// PHP version. For example &quot;8.1&quot;
+	PhpVersion string `json:"phpVersion,omitempty" mapstructure:"php_version"`
+
 	CallbackID string `json:"-" mapstructure:"-"`
 }
 
@@ -192,13 +195,7 @@ func (c *Client) GetServers(ctx context.Context, params GetServersParams) (Serve
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get servers error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting servers: %w", apiError)
+		return nil, fmt.Errorf("error getting servers: %w", newAPIError(res))
 	}
 
 	servers := Servers{}
@@ -227,7 +224,7 @@ func (c *Client) CreateServer(ctx context.Context, body CreateServerRequestBody)
 
 	serverURL.Path += "servers"
 
-	req, err := http.NewRequestWithContext(ctx, "POST", serverURL.String(), bodyReader)
+	req, err := http.NewRequestWithContext(withCreateOperation(ctx), "POST", serverURL.String(), bodyReader)
 	if err != nil {
 		return nil, err
 	}
@@ -240,13 +237,7 @@ func (c *Client) CreateServer(ctx context.Context, body CreateServerRequestBody)
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding create server error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error creating server: %w", apiError)
+		return nil, fmt.Errorf("error creating server: %w", newAPIError(res))
 	}
 
 	server := Server{}
@@ -283,13 +274,7 @@ func (c *Client) DeleteServer(ctx context.Context, serverSlug string) (string, e
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return "", fmt.Errorf("error decoding delete server error response body: %w", err)
-		}
-
-		return "", fmt.Errorf("error deleting server: %w", apiError)
+		return "", fmt.Errorf("error deleting server: %w", newAPIError(res))
 	}
 
 	return res.Header.Get("X-Callback-Id"), nil
@@ -318,17 +303,7 @@ func (c *Client) GetServerBySlug(ctx context.Context, serverSlug string) (*Serve
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		if res.StatusCode == http.StatusNotFound {
-			return nil, ErrServerNotFound
-		}
-
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding get server by slug error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error getting server by slug: %w", apiError)
+		return nil, fmt.Errorf("error getting server by slug: %w", newAPIError(res))
 	}
 
 	var server Server
@@ -372,13 +347,7 @@ func (c *Client) PatchServer(ctx context.Context, serverSlug string, body PatchS
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding patch server error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error patching server: %w", apiError)
+		return nil, fmt.Errorf("error patching server: %w", newAPIError(res))
 	}
 
 	var server Server
@@ -422,13 +391,7 @@ func (c *Client) ReinstallServer(ctx context.Context, serverSlug string, body Re
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return "", fmt.Errorf("error decoding reinstall server error response body: %w", err)
-		}
-
-		return "", fmt.Errorf("error reinstalling server: %w", apiError)
+		return "", fmt.Errorf("error reinstalling server: %w", newAPIError(res))
 	}
 
 	return res.Header.Get("X-Callback-Id"), nil
@@ -466,13 +429,7 @@ func (c *Client) ResizeServer(ctx context.Context, serverSlug string, body Resiz
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return "", fmt.Errorf("error decoding resize server error response body: %w", err)
-		}
-
-		return "", fmt.Errorf("error resizing server: %w", apiError)
+		return "", fmt.Errorf("error resizing server: %w", newAPIError(res))
 	}
 
 	return res.Header.Get("X-Callback-Id"), nil
@@ -510,13 +467,7 @@ func (c *Client) ResizeDryRun(ctx context.Context, serverSlug string, body Resiz
 	defer res.Body.Close()
 
 	if errorStatus(res.StatusCode) {
-		apiError := APIError{}
-
-		if err := json.NewDecoder(res.Body).Decode(&apiError); err != nil {
-			return nil, fmt.Errorf("error decoding dry run resize server error response body: %w", err)
-		}
-
-		return nil, fmt.Errorf("error dry run resizing server: %w", apiError)
+		return nil, fmt.Errorf("error dry run resizing server: %w", newAPIError(res))
 	}
 
 	serverResize := ServerResize{}
@@ -527,3 +478,27 @@ func (c *Client) ResizeDryRun(ctx context.Context, serverSlug string, body Resiz
 
 	return &serverResize, nil
 }
+
+func (c *Client) StartServer(ctx context.Context, serverSlug string) (string, error) {
+	path := fmt.Sprintf("servers/%s/actions/start", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, nil, "error starting server")
+}
+
+func (c *Client) StopServer(ctx context.Context, serverSlug string) (string, error) {
+	path := fmt.Sprintf("servers/%s/actions/stop", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, nil, "error stopping server")
+}
+
+func (c *Client) RebootServer(ctx context.Context, serverSlug string) (string, error) {
+	path := fmt.Sprintf("servers/%s/actions/reboot", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, nil, "error rebooting server")
+}
+
+func (c *Client) SuspendServer(ctx context.Context, serverSlug string) (string, error) {
+	path := fmt.Sprintf("servers/%s/actions/suspend", url.PathEscape(serverSlug))
+
+	return c.callbackAction(ctx, "POST", path, nil, "error suspending server")
+}
@@ -5,55 +5,44 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
-	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/zolamk/terraform-provider-webdock/api"
 )
 
 type Config struct {
-	Token            string
-	APIEndpoint      string
-	TerraformVersion string
-	ServerUpPort     int
-	RetryLimit       int
-}
-
-type Counter struct {
-	mu sync.Mutex
-	x  int64
-}
-
-func (c *Counter) Inc() {
-	c.mu.Lock()
-	c.x++
-	c.mu.Unlock()
-}
-
-func (c *Counter) Value() (x int64) {
-	c.mu.Lock()
-	x = c.x
-	c.mu.Unlock()
-	return
+	Token                   string
+	APIEndpoint             string
+	TerraformVersion        string
+	ServerUpPort            int
+	RetryLimit              int
+	RequestsPerSecond       float64
+	RequestBurst            int
+	CreateRequestsPerMinute float64
+	CreateRequestBurst      int
+	PollDelay               time.Duration
+	MinBackoff              time.Duration
+	AddressFamily           string
 }
 
 type CombinedConfig struct {
 	api.ClientInterface
-	Logger              *slog.Logger
-	CreatedServersCount Counter
-	CreateUsersCount    Counter
-	ServerUpPort        int
-	RetryLimit          int
+	Logger        *slog.Logger
+	ServerUpPort  int
+	PollDelay     time.Duration
+	MinBackoff    time.Duration
+	AddressFamily string
 }
 
 func NewCombinedConfig(config *Config, client api.ClientInterface) *CombinedConfig {
 	return &CombinedConfig{
 		client,
 		slog.New(slog.NewTextHandler(os.Stdout, nil)),
-		Counter{},
-		Counter{},
 		config.ServerUpPort,
-		config.RetryLimit,
+		config.PollDelay,
+		config.MinBackoff,
+		config.AddressFamily,
 	}
 }
 
@@ -65,17 +54,22 @@ func setAuthorization(c *Config) api.RequestEditorFn {
 }
 
 func (c *Config) Client() (*CombinedConfig, diag.Diagnostics) {
-	webdockClient, err := api.NewClient(c.APIEndpoint+"/v1", api.WithRequestEditorFn(setAuthorization(c)))
+	webdockClient, err := api.NewClient(
+		c.APIEndpoint+"/v1",
+		api.WithRequestEditorFn(setAuthorization(c)),
+		api.WithRetryPolicy(api.RetryPolicy{
+			MaxRetries: c.RetryLimit,
+		}),
+		api.WithRateLimit(api.RateLimit{
+			RequestsPerSecond:       c.RequestsPerSecond,
+			Burst:                   c.RequestBurst,
+			CreateRequestsPerSecond: c.CreateRequestsPerMinute / 60,
+			CreateBurst:             c.CreateRequestBurst,
+		}),
+	)
 	if err != nil {
 		return nil, diag.Errorf("error creating api client: %v", err)
 	}
 
-	return &CombinedConfig{
-		webdockClient,
-		slog.New(slog.NewTextHandler(os.Stdout, nil)),
-		Counter{},
-		Counter{},
-		c.ServerUpPort,
-		c.RetryLimit,
-	}, nil
+	return NewCombinedConfig(c, webdockClient), nil
 }
@@ -0,0 +1,2 @@
+# Hooks can be imported using their ID
+terraform import webdock_hook.provision 12345
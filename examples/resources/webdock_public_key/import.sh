@@ -0,0 +1,2 @@
+# Public keys can be imported using their ID
+terraform import webdock_public_key.key 12345
@@ -0,0 +1,2 @@
+# Scripts can be imported using their ID
+terraform import webdock_script.bootstrap 12345
@@ -0,0 +1,2 @@
+# Servers can be imported using their slug
+terraform import webdock_server.server myserver
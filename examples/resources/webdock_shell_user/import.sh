@@ -0,0 +1,3 @@
+# Shell users can be imported using the slug of their server and their ID separated by a slash, their password isn't
+# known so the first apply after importing sets it again
+terraform import webdock_shell_user.user myserver/12345
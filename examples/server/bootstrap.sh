@@ -0,0 +1,3 @@
+#!/bin/bash
+
+apt-get update && apt-get upgrade -y
@@ -25,12 +25,21 @@ data "webdock_profiles" "profiles" {
 
 data "webdock_public_keys" "public_keys" {}
 
+data "webdock_account" "account" {}
+
 resource "webdock_server" "server" {
   count = var.server_instance_count
   name = "Server ${count.index + 1}"
   image_slug = "webdock-ubuntu-jammy-cloud"
   profile_slug = "webdockbit-2022"
   location_id = "fi"
+
+  lifecycle {
+    precondition {
+      condition = data.webdock_account.account.server_limit == 0 || data.webdock_account.account.server_count + var.server_instance_count <= data.webdock_account.account.server_limit
+      error_message = "The account can't host ${var.server_instance_count} more servers."
+    }
+  }
 }
 
 resource "random_string" "server_user_password" {
@@ -49,3 +58,20 @@ resource "webdock_shell_user" "nomad_server_user" {
   server_slug = webdock_server.server[count.index].slug
   public_keys = [ data.webdock_public_keys.public_keys.public_keys[0].id ]
 }
+
+resource "webdock_script" "bootstrap" {
+  name = "bootstrap"
+  filename = "bootstrap.sh"
+  content = file("${path.module}/bootstrap.sh")
+}
+
+resource "webdock_server_script" "bootstrap" {
+  count = var.server_instance_count
+  server_slug = webdock_server.server[count.index].slug
+  script_id = webdock_script.bootstrap.id
+  path = "/root/bootstrap.sh"
+
+  triggers = {
+    content = sha1(webdock_script.bootstrap.content)
+  }
+}
@@ -15,6 +15,50 @@ type ClientInterface struct {
 	mock.Mock
 }
 
+// AddServerAlias provides a mock function with given fields: ctx, serverSlug, domain
+func (_m *ClientInterface) AddServerAlias(ctx context.Context, serverSlug string, domain string) (string, error) {
+	ret := _m.Called(ctx, serverSlug, domain)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, serverSlug, domain)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serverSlug, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHook provides a mock function with given fields: ctx, body
+func (_m *ClientInterface) CreateHook(ctx context.Context, body api.CreateHookRequestBody) (*api.Hook, error) {
+	ret := _m.Called(ctx, body)
+
+	var r0 *api.Hook
+	if rf, ok := ret.Get(0).(func(context.Context, api.CreateHookRequestBody) *api.Hook); ok {
+		r0 = rf(ctx, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Hook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.CreateHookRequestBody) error); ok {
+		r1 = rf(ctx, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePublicKey provides a mock function with given fields: ctx, body
 func (_m *ClientInterface) CreatePublicKey(ctx context.Context, body api.CreatePublicKeyRequestBody) (*api.PublicKey, error) {
 	ret := _m.Called(ctx, body)
@@ -38,6 +82,29 @@ func (_m *ClientInterface) CreatePublicKey(ctx context.Context, body api.CreateP
 	return r0, r1
 }
 
+// CreateScript provides a mock function with given fields: ctx, body
+func (_m *ClientInterface) CreateScript(ctx context.Context, body api.ScriptRequestBody) (*api.Script, error) {
+	ret := _m.Called(ctx, body)
+
+	var r0 *api.Script
+	if rf, ok := ret.Get(0).(func(context.Context, api.ScriptRequestBody) *api.Script); ok {
+		r0 = rf(ctx, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Script)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.ScriptRequestBody) error); ok {
+		r1 = rf(ctx, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateServer provides a mock function with given fields: ctx, body
 func (_m *ClientInterface) CreateServer(ctx context.Context, body api.CreateServerRequestBody) (*api.Server, error) {
 	ret := _m.Called(ctx, body)
@@ -61,6 +128,52 @@ func (_m *ClientInterface) CreateServer(ctx context.Context, body api.CreateServ
 	return r0, r1
 }
 
+// CreateServerScript provides a mock function with given fields: ctx, serverSlug, body
+func (_m *ClientInterface) CreateServerScript(ctx context.Context, serverSlug string, body api.CreateServerScriptRequestBody) (*api.ServerScript, error) {
+	ret := _m.Called(ctx, serverSlug, body)
+
+	var r0 *api.ServerScript
+	if rf, ok := ret.Get(0).(func(context.Context, string, api.CreateServerScriptRequestBody) *api.ServerScript); ok {
+		r0 = rf(ctx, serverSlug, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ServerScript)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, api.CreateServerScriptRequestBody) error); ok {
+		r1 = rf(ctx, serverSlug, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateServerSnapshot provides a mock function with given fields: ctx, serverSlug, body
+func (_m *ClientInterface) CreateServerSnapshot(ctx context.Context, serverSlug string, body api.CreateSnapshotRequestBody) (*api.Snapshot, error) {
+	ret := _m.Called(ctx, serverSlug, body)
+
+	var r0 *api.Snapshot
+	if rf, ok := ret.Get(0).(func(context.Context, string, api.CreateSnapshotRequestBody) *api.Snapshot); ok {
+		r0 = rf(ctx, serverSlug, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Snapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, api.CreateSnapshotRequestBody) error); ok {
+		r1 = rf(ctx, serverSlug, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateShellUser provides a mock function with given fields: ctx, serverSlug, shellUser
 func (_m *ClientInterface) CreateShellUser(ctx context.Context, serverSlug string, shellUser api.CreateShellUserRequestBody) (*api.ShellUser, error) {
 	ret := _m.Called(ctx, serverSlug, shellUser)
@@ -84,6 +197,20 @@ func (_m *ClientInterface) CreateShellUser(ctx context.Context, serverSlug strin
 	return r0, r1
 }
 
+// DeleteHook provides a mock function with given fields: ctx, hookID
+func (_m *ClientInterface) DeleteHook(ctx context.Context, hookID int64) error {
+	ret := _m.Called(ctx, hookID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, hookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeletePublicKey provides a mock function with given fields: ctx, id
 func (_m *ClientInterface) DeletePublicKey(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -98,6 +225,20 @@ func (_m *ClientInterface) DeletePublicKey(ctx context.Context, id int64) error
 	return r0
 }
 
+// DeleteScript provides a mock function with given fields: ctx, scriptID
+func (_m *ClientInterface) DeleteScript(ctx context.Context, scriptID int64) error {
+	ret := _m.Called(ctx, scriptID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, scriptID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteServer provides a mock function with given fields: ctx, serverSlug
 func (_m *ClientInterface) DeleteServer(ctx context.Context, serverSlug string) (string, error) {
 	ret := _m.Called(ctx, serverSlug)
@@ -119,6 +260,48 @@ func (_m *ClientInterface) DeleteServer(ctx context.Context, serverSlug string)
 	return r0, r1
 }
 
+// DeleteServerScript provides a mock function with given fields: ctx, serverSlug, serverScriptID
+func (_m *ClientInterface) DeleteServerScript(ctx context.Context, serverSlug string, serverScriptID int64) (string, error) {
+	ret := _m.Called(ctx, serverSlug, serverScriptID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) string); ok {
+		r0 = rf(ctx, serverSlug, serverScriptID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, serverSlug, serverScriptID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteServerSnapshot provides a mock function with given fields: ctx, serverSlug, snapshotID
+func (_m *ClientInterface) DeleteServerSnapshot(ctx context.Context, serverSlug string, snapshotID int64) (string, error) {
+	ret := _m.Called(ctx, serverSlug, snapshotID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) string); ok {
+		r0 = rf(ctx, serverSlug, snapshotID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, serverSlug, snapshotID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteShellUser provides a mock function with given fields: ctx, serverSlug, shellUserID
 func (_m *ClientInterface) DeleteShellUser(ctx context.Context, serverSlug string, shellUserID int64) (string, error) {
 	ret := _m.Called(ctx, serverSlug, shellUserID)
@@ -140,6 +323,29 @@ func (_m *ClientInterface) DeleteShellUser(ctx context.Context, serverSlug strin
 	return r0, r1
 }
 
+// GetAccountInformation provides a mock function with given fields: ctx
+func (_m *ClientInterface) GetAccountInformation(ctx context.Context) (*api.AccountInformation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *api.AccountInformation
+	if rf, ok := ret.Get(0).(func(context.Context) *api.AccountInformation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.AccountInformation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEvents provides a mock function with given fields: ctx, params
 func (_m *ClientInterface) GetEvents(ctx context.Context, params api.GetEventsParams) (api.Events, error) {
 	ret := _m.Called(ctx, params)
@@ -163,6 +369,52 @@ func (_m *ClientInterface) GetEvents(ctx context.Context, params api.GetEventsPa
 	return r0, r1
 }
 
+// GetEventsPage provides a mock function with given fields: ctx, params
+func (_m *ClientInterface) GetEventsPage(ctx context.Context, params api.GetEventsParams) (*api.EventsPage, error) {
+	ret := _m.Called(ctx, params)
+
+	var r0 *api.EventsPage
+	if rf, ok := ret.Get(0).(func(context.Context, api.GetEventsParams) *api.EventsPage); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.EventsPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, api.GetEventsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHooks provides a mock function with given fields: ctx
+func (_m *ClientInterface) GetHooks(ctx context.Context) (api.Hooks, error) {
+	ret := _m.Called(ctx)
+
+	var r0 api.Hooks
+	if rf, ok := ret.Get(0).(func(context.Context) api.Hooks); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Hooks)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPublicKeys provides a mock function with given fields: ctx
 func (_m *ClientInterface) GetPublicKeys(ctx context.Context) (api.PublicKeys, error) {
 	ret := _m.Called(ctx)
@@ -186,6 +438,52 @@ func (_m *ClientInterface) GetPublicKeys(ctx context.Context) (api.PublicKeys, e
 	return r0, r1
 }
 
+// GetScript provides a mock function with given fields: ctx, scriptID
+func (_m *ClientInterface) GetScript(ctx context.Context, scriptID int64) (*api.Script, error) {
+	ret := _m.Called(ctx, scriptID)
+
+	var r0 *api.Script
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *api.Script); ok {
+		r0 = rf(ctx, scriptID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Script)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, scriptID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScripts provides a mock function with given fields: ctx
+func (_m *ClientInterface) GetScripts(ctx context.Context) (api.Scripts, error) {
+	ret := _m.Called(ctx)
+
+	var r0 api.Scripts
+	if rf, ok := ret.Get(0).(func(context.Context) api.Scripts); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Scripts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetServerBySlug provides a mock function with given fields: ctx, serverSlug
 func (_m *ClientInterface) GetServerBySlug(ctx context.Context, serverSlug string) (*api.Server, error) {
 	ret := _m.Called(ctx, serverSlug)
@@ -209,6 +507,98 @@ func (_m *ClientInterface) GetServerBySlug(ctx context.Context, serverSlug strin
 	return r0, r1
 }
 
+// GetServerMetrics provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) GetServerMetrics(ctx context.Context, serverSlug string) (*api.ServerMetrics, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 *api.ServerMetrics
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.ServerMetrics); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ServerMetrics)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerMetricsNow provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) GetServerMetricsNow(ctx context.Context, serverSlug string) (*api.ServerMetrics, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 *api.ServerMetrics
+	if rf, ok := ret.Get(0).(func(context.Context, string) *api.ServerMetrics); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ServerMetrics)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerScripts provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) GetServerScripts(ctx context.Context, serverSlug string) (api.ServerScripts, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 api.ServerScripts
+	if rf, ok := ret.Get(0).(func(context.Context, string) api.ServerScripts); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.ServerScripts)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetServerSnapshots provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) GetServerSnapshots(ctx context.Context, serverSlug string) (api.Snapshots, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 api.Snapshots
+	if rf, ok := ret.Get(0).(func(context.Context, string) api.Snapshots); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(api.Snapshots)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetServers provides a mock function with given fields: ctx, params
 func (_m *ClientInterface) GetServers(ctx context.Context, params api.GetServersParams) (api.Servers, error) {
 	ret := _m.Called(ctx, params)
@@ -347,6 +737,27 @@ func (_m *ClientInterface) PatchServer(ctx context.Context, serverSlug string, b
 	return r0, r1
 }
 
+// RebootServer provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) RebootServer(ctx context.Context, serverSlug string) (string, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReinstallServer provides a mock function with given fields: ctx, serverSlug, body
 func (_m *ClientInterface) ReinstallServer(ctx context.Context, serverSlug string, body api.ReinstallServerRequestBody) (string, error) {
 	ret := _m.Called(ctx, serverSlug, body)
@@ -368,6 +779,27 @@ func (_m *ClientInterface) ReinstallServer(ctx context.Context, serverSlug strin
 	return r0, r1
 }
 
+// RemoveServerAlias provides a mock function with given fields: ctx, serverSlug, domain
+func (_m *ClientInterface) RemoveServerAlias(ctx context.Context, serverSlug string, domain string) (string, error) {
+	ret := _m.Called(ctx, serverSlug, domain)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, serverSlug, domain)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serverSlug, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResizeDryRun provides a mock function with given fields: ctx, serverSlug, body
 func (_m *ClientInterface) ResizeDryRun(ctx context.Context, serverSlug string, body api.ResizeServerRequestBody) (*api.ServerResize, error) {
 	ret := _m.Called(ctx, serverSlug, body)
@@ -412,6 +844,241 @@ func (_m *ClientInterface) ResizeServer(ctx context.Context, serverSlug string,
 	return r0, r1
 }
 
+// RestoreServerSnapshot provides a mock function with given fields: ctx, serverSlug, body
+func (_m *ClientInterface) RestoreServerSnapshot(ctx context.Context, serverSlug string, body api.RestoreSnapshotRequestBody) (string, error) {
+	ret := _m.Called(ctx, serverSlug, body)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, api.RestoreSnapshotRequestBody) string); ok {
+		r0 = rf(ctx, serverSlug, body)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, api.RestoreSnapshotRequestBody) error); ok {
+		r1 = rf(ctx, serverSlug, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerMainDomain provides a mock function with given fields: ctx, serverSlug, domain
+func (_m *ClientInterface) SetServerMainDomain(ctx context.Context, serverSlug string, domain string) (string, error) {
+	ret := _m.Called(ctx, serverSlug, domain)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, serverSlug, domain)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serverSlug, domain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerPhpVersion provides a mock function with given fields: ctx, serverSlug, phpVersion
+func (_m *ClientInterface) SetServerPhpVersion(ctx context.Context, serverSlug string, phpVersion string) (string, error) {
+	ret := _m.Called(ctx, serverSlug, phpVersion)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, serverSlug, phpVersion)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serverSlug, phpVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerSSHPasswordAuth provides a mock function with given fields: ctx, serverSlug, enabled
+func (_m *ClientInterface) SetServerSSHPasswordAuth(ctx context.Context, serverSlug string, enabled bool) (string, error) {
+	ret := _m.Called(ctx, serverSlug, enabled)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) string); ok {
+		r0 = rf(ctx, serverSlug, enabled)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, serverSlug, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerWebServer provides a mock function with given fields: ctx, serverSlug, webServer
+func (_m *ClientInterface) SetServerWebServer(ctx context.Context, serverSlug string, webServer string) (string, error) {
+	ret := _m.Called(ctx, serverSlug, webServer)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, serverSlug, webServer)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serverSlug, webServer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetServerWordPressLockdown provides a mock function with given fields: ctx, serverSlug, enabled
+func (_m *ClientInterface) SetServerWordPressLockdown(ctx context.Context, serverSlug string, enabled bool) (string, error) {
+	ret := _m.Called(ctx, serverSlug, enabled)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) string); ok {
+		r0 = rf(ctx, serverSlug, enabled)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, serverSlug, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartServer provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) StartServer(ctx context.Context, serverSlug string) (string, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopServer provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) StopServer(ctx context.Context, serverSlug string) (string, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SuspendServer provides a mock function with given fields: ctx, serverSlug
+func (_m *ClientInterface) SuspendServer(ctx context.Context, serverSlug string) (string, error) {
+	ret := _m.Called(ctx, serverSlug)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, serverSlug)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serverSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateScript provides a mock function with given fields: ctx, scriptID, body
+func (_m *ClientInterface) UpdateScript(ctx context.Context, scriptID int64, body api.ScriptRequestBody) (*api.Script, error) {
+	ret := _m.Called(ctx, scriptID, body)
+
+	var r0 *api.Script
+	if rf, ok := ret.Get(0).(func(context.Context, int64, api.ScriptRequestBody) *api.Script); ok {
+		r0 = rf(ctx, scriptID, body)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Script)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, api.ScriptRequestBody) error); ok {
+		r1 = rf(ctx, scriptID, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateShellUserPassword provides a mock function with given fields: ctx, serverSlug, shellUserID, password
+func (_m *ClientInterface) UpdateShellUserPassword(ctx context.Context, serverSlug string, shellUserID int64, password string) (*api.ShellUser, error) {
+	ret := _m.Called(ctx, serverSlug, shellUserID, password)
+
+	var r0 *api.ShellUser
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string) *api.ShellUser); ok {
+		r0 = rf(ctx, serverSlug, shellUserID, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ShellUser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string) error); ok {
+		r1 = rf(ctx, serverSlug, shellUserID, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateShellUserPublicKeys provides a mock function with given fields: ctx, serverSlug, shellUserID, publicKeys
 func (_m *ClientInterface) UpdateShellUserPublicKeys(ctx context.Context, serverSlug string, shellUserID int64, publicKeys []int) (*api.ShellUser, error) {
 	ret := _m.Called(ctx, serverSlug, shellUserID, publicKeys)
@@ -0,0 +1,66 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+func Account() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readAccount,
+		Schema:      schemas.Account(),
+	}
+}
+
+func readAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	account, err := client.GetAccountInformation(ctx)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	servers, err := client.GetServers(ctx, api.GetServersParams{})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var balance float64
+
+	if account.AccountBalanceRaw != "" {
+		if balance, err = account.AccountBalanceRaw.Float64(); err != nil {
+			return diag.Errorf("error parsing account balance: %s", err)
+		}
+	}
+
+	d.SetId("account")
+
+	values := map[string]interface{}{
+		"user_id":                  account.UserID,
+		"user_name":                account.UserName,
+		"user_email":               account.UserEmail,
+		"company_name":             account.CompanyName,
+		"is_team_member":           account.IsTeamMember,
+		"team_leader":              account.TeamLeader,
+		"account_balance":          account.AccountBalance,
+		"account_balance_raw":      balance,
+		"account_balance_currency": account.AccountBalanceRawCurrency,
+		"server_limit":             account.ServerLimit,
+		"server_count":             len(servers),
+	}
+
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package datasource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/datasource"
+)
+
+func TestDataSourceWebdockAccount(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.ClientInterface{}
+	mockErr := errors.New("mock error")
+
+	tests := map[string]struct {
+		rd          *schema.ResourceData
+		diags       diag.Diagnostics
+		wantBalance float64
+		wantServers int
+		mock        func()
+	}{
+		"success": {
+			rd:          datasource.Account().Data(&terraform.InstanceState{}),
+			wantBalance: 1234,
+			wantServers: 2,
+			mock: func() {
+				client.On("GetAccountInformation", ctx).Once().Return(&api.AccountInformation{
+					UserID:                    1,
+					AccountBalanceRaw:         json.Number("1234"),
+					AccountBalanceRawCurrency: "EUR",
+					ServerLimit:               10,
+				}, nil)
+				client.On("GetServers", ctx, mock.Anything).Once().Return(api.Servers{{Slug: "one"}, {Slug: "two"}}, nil)
+			},
+		},
+		"error: get account information": {
+			rd: datasource.Account().Data(&terraform.InstanceState{}),
+			mock: func() {
+				client.On("GetAccountInformation", ctx).Once().Return(nil, mockErr)
+			},
+			diags: diag.FromErr(errors.New("mock error")),
+		},
+		"error: get servers": {
+			rd: datasource.Account().Data(&terraform.InstanceState{}),
+			mock: func() {
+				client.On("GetAccountInformation", ctx).Once().Return(&api.AccountInformation{}, nil)
+				client.On("GetServers", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+			diags: diag.FromErr(errors.New("mock error")),
+		},
+		"error: parse account balance": {
+			rd: datasource.Account().Data(&terraform.InstanceState{}),
+			mock: func() {
+				client.On("GetAccountInformation", ctx).Once().Return(&api.AccountInformation{
+					AccountBalanceRaw: json.Number("unknown"),
+				}, nil)
+				client.On("GetServers", ctx, mock.Anything).Once().Return(api.Servers{}, nil)
+			},
+			diags: diag.Errorf("error parsing account balance: strconv.ParseFloat: parsing \"unknown\": invalid syntax"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := datasource.Account().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantBalance, test.rd.Get("account_balance_raw"))
+
+			assert.Equal(t, test.wantServers, test.rd.Get("server_count"))
+		})
+	}
+}
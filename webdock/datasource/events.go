@@ -0,0 +1,156 @@
+package datasource
+
+import (
+	"context"
+	"time"
+
+	// time_zone has to work on hosts without a time zone database, like Windows
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+// eventsPerPage is how many events are requested per page while walking the event log
+const eventsPerPage = 100
+
+// eventTimeLayout is the day-first layout the API documents for event start times, e.g. "25/10/2022 05:11:34"
+const eventTimeLayout = "02/01/2006 15:04:05"
+
+func Events() *schema.Resource {
+	datasourceSchema := map[string]*schema.Schema{
+		"server_slug": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return events of this server",
+		},
+		"event_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(api.EventTypes, false),
+			Description:  "Only return events of this type",
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{api.EventStatusWaiting, api.EventStatusWorking, api.EventStatusFinished, api.EventStatusError}, false),
+			Description:  "Only return events with this status",
+		},
+		"since": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Only return events started at or after this RFC 3339 time",
+		},
+		"until": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "Only return events started before this RFC 3339 time",
+		},
+		"time_zone": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "UTC",
+			Description: "IANA time zone event start times are read in when filtering by since or until, the API reports them without an offset",
+		},
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Maximum number of events to return, all matching events are returned when 0",
+		},
+		"events": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: schemas.Event(),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: readEvents,
+		Schema:      datasourceSchema,
+	}
+}
+
+func readEvents(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	var since, until time.Time
+
+	location, err := time.LoadLocation(d.Get("time_zone").(string))
+	if err != nil {
+		return diag.Errorf("error loading time zone: %s", err)
+	}
+
+	if v := d.Get("since").(string); v != "" {
+		since, _ = time.Parse(time.RFC3339, v)
+	}
+
+	if v := d.Get("until").(string); v != "" {
+		until, _ = time.Parse(time.RFC3339, v)
+	}
+
+	serverSlug := d.Get("server_slug").(string)
+	status := d.Get("status").(string)
+	limit := d.Get("limit").(int)
+
+	it := api.NewEventIterator(client, api.GetEventsParams{
+		EventType: d.Get("event_type").(string),
+		PerPage:   eventsPerPage,
+	})
+
+	events := api.Events{}
+
+	for it.Next(ctx) {
+		event := it.Event()
+
+		if serverSlug != "" && event.ServerSlug != serverSlug {
+			continue
+		}
+
+		if status != "" && event.Status != status {
+			continue
+		}
+
+		if !since.IsZero() || !until.IsZero() {
+			started, err := time.ParseInLocation(eventTimeLayout, event.StartTime, location)
+			if err != nil {
+				return diag.Errorf("error parsing start time of event %s: %s", event.Id, err)
+			}
+
+			// nothing guarantees the event log is listed newest first, so every page is checked
+			if !since.IsZero() && started.Before(since) {
+				continue
+			}
+
+			if !until.IsZero() && !started.Before(until) {
+				continue
+			}
+		}
+
+		events = append(events, event)
+
+		if limit > 0 && len(events) == limit {
+			break
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("events")
+
+	if err := d.Set("events", events); err != nil {
+		return diag.Errorf("error setting events: %s", err)
+	}
+
+	return nil
+}
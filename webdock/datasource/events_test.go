@@ -0,0 +1,183 @@
+package datasource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/datasource"
+)
+
+func TestDataSourceWebdockEvents(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.ClientInterface{}
+	mockErr := errors.New("mock error")
+
+	pages := func() {
+		client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+			Events: api.Events{
+				{Id: "1", ServerSlug: "server1", Status: "finished", StartTime: "25/10/2022 05:11:34"},
+				{Id: "2", ServerSlug: "server2", Status: "finished", StartTime: "25/10/2022 04:11:34"},
+			},
+			NextPage: 2,
+		}, nil)
+		client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 2, PerPage: 100}).Once().Return(&api.EventsPage{
+			Events: api.Events{
+				{Id: "3", ServerSlug: "server1", Status: "error", StartTime: "24/10/2022 05:11:34"},
+			},
+		}, nil)
+	}
+
+	tests := map[string]struct {
+		raw     map[string]interface{}
+		diags   diag.Diagnostics
+		wantIDs []string
+		mock    func()
+	}{
+		"success": {
+			raw:     map[string]interface{}{},
+			wantIDs: []string{"1", "2", "3"},
+			mock:    pages,
+		},
+		"success: filtered by server slug and status": {
+			raw: map[string]interface{}{
+				"server_slug": "server1",
+				"status":      "finished",
+			},
+			wantIDs: []string{"1"},
+			mock:    pages,
+		},
+		"success: filtered by time window": {
+			raw: map[string]interface{}{
+				"since": "2022-10-25T00:00:00Z",
+				"until": "2022-10-25T05:00:00Z",
+			},
+			wantIDs: []string{"2"},
+			mock:    pages,
+		},
+		"success: pages past events older than since": {
+			raw: map[string]interface{}{
+				"since": "2022-10-25T05:00:00Z",
+			},
+			wantIDs: []string{"1", "3"},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events: api.Events{
+						{Id: "1", StartTime: "25/10/2022 05:11:34"},
+						{Id: "2", StartTime: "25/10/2022 04:11:34"},
+					},
+					NextPage: 2,
+				}, nil)
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 2, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events: api.Events{
+						{Id: "3", StartTime: "25/10/2022 06:11:34"},
+					},
+				}, nil)
+			},
+		},
+		"success: filtered by time window in time zone": {
+			raw: map[string]interface{}{
+				"since":     "2022-10-25T03:00:00Z",
+				"until":     "2022-10-25T04:00:00Z",
+				"time_zone": "Europe/Copenhagen",
+			},
+			// 25/10/2022 05:11:34 in Copenhagen is 03:11:34 UTC
+			wantIDs: []string{"1"},
+			mock:    pages,
+		},
+		"success: limited": {
+			raw: map[string]interface{}{
+				"limit": 1,
+			},
+			wantIDs: []string{"1"},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events:   api.Events{{Id: "1"}, {Id: "2"}},
+					NextPage: 2,
+				}, nil)
+			},
+		},
+		"success: filtered by event type": {
+			raw: map[string]interface{}{
+				"event_type": "start",
+			},
+			wantIDs: []string{"1"},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{EventType: "start", Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events: api.Events{{Id: "1", EventType: "start"}},
+				}, nil)
+			},
+		},
+		"error: get events page": {
+			raw: map[string]interface{}{},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(nil, mockErr)
+			},
+			diags: diag.FromErr(errors.New("mock error")),
+		},
+		"error: parse start time": {
+			raw: map[string]interface{}{
+				"since": "2022-10-25T00:00:00Z",
+			},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events: api.Events{{Id: "1", StartTime: "yesterday"}},
+				}, nil)
+			},
+			diags: diag.Errorf("error parsing start time of event 1: parsing time \"yesterday\" as \"02/01/2006 15:04:05\": cannot parse \"yesterday\" as \"02\""),
+		},
+		"error: start time in an undocumented layout": {
+			raw: map[string]interface{}{
+				"since": "2022-10-25T00:00:00Z",
+			},
+			mock: func() {
+				client.On("GetEventsPage", ctx, api.GetEventsParams{Page: 1, PerPage: 100}).Once().Return(&api.EventsPage{
+					Events: api.Events{{Id: "1", StartTime: "2022-10-25 05:11:34"}},
+				}, nil)
+			},
+			diags: diag.Errorf("error parsing start time of event 1: parsing time \"2022-10-25 05:11:34\" as \"02/01/2006 15:04:05\": cannot parse \"22-10-25 05:11:34\" as \"/\""),
+		},
+		"error: unknown time zone": {
+			raw: map[string]interface{}{
+				"time_zone": "Mars/Olympus_Mons",
+			},
+			mock:  func() {},
+			diags: diag.Errorf("error loading time zone: unknown time zone Mars/Olympus_Mons"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := datasource.Events().TestResourceData()
+
+			for key, value := range test.raw {
+				assert.Nil(t, rd.Set(key, value))
+			}
+
+			diags := datasource.Events().ReadContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if test.diags.HasError() {
+				return
+			}
+
+			ids := []string{}
+
+			for _, event := range rd.Get("events").([]interface{}) {
+				ids = append(ids, event.(map[string]interface{})["id"].(string))
+			}
+
+			assert.Equal(t, test.wantIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+func ServerMetrics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: readServerMetrics,
+		Schema:      schemas.ServerMetrics(),
+	}
+}
+
+func readServerMetrics(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	serverSlug := d.Get("server_slug").(string)
+
+	var (
+		metrics *api.ServerMetrics
+		err     error
+	)
+
+	if d.Get("include_history").(bool) {
+		metrics, err = client.GetServerMetrics(ctx, serverSlug)
+	} else {
+		metrics, err = client.GetServerMetricsNow(ctx, serverSlug)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cpuAverage, cpuMax := summarizeUsage(metrics.CPU)
+	memoryAverage, memoryMax := summarizeUsage(metrics.Memory)
+
+	d.SetId(serverSlug)
+
+	values := map[string]interface{}{
+		"sampled_at":                metrics.CPU.Latest.Timestamp,
+		"cpu_usage":                 metrics.CPU.Latest.Amount,
+		"cpu_usage_average":         cpuAverage,
+		"cpu_usage_max":             cpuMax,
+		"memory_usage":              metrics.Memory.Latest.Amount,
+		"memory_usage_average":      memoryAverage,
+		"memory_usage_max":          memoryMax,
+		"memory_allowed":            metrics.Memory.Allowed,
+		"disk_usage":                metrics.Disk.Latest.Amount,
+		"disk_allowed":              metrics.Disk.Allowed,
+		"network_total":             metrics.Network.Total,
+		"network_allowed":           metrics.Network.Allowed,
+		"network_ingress":           metrics.Network.LatestIngress.Amount,
+		"network_egress":            metrics.Network.LatestEgress.Amount,
+		"cpu_samplings":             metrics.CPU.Samplings,
+		"memory_samplings":          metrics.Memory.Samplings,
+		"disk_samplings":            metrics.Disk.Samplings,
+		"network_ingress_samplings": metrics.Network.IngressSamplings,
+		"network_egress_samplings":  metrics.Network.EgressSamplings,
+	}
+
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// summarizeUsage returns the average and maximum of the usage samples, or the latest usage when there are none
+func summarizeUsage(usage api.UsageMetric) (float64, float64) {
+	if len(usage.Samplings) == 0 {
+		return usage.Latest.Amount, usage.Latest.Amount
+	}
+
+	var sum, maximum float64
+
+	for i, sampling := range usage.Samplings {
+		sum += sampling.Amount
+
+		if i == 0 || sampling.Amount > maximum {
+			maximum = sampling.Amount
+		}
+	}
+
+	return sum / float64(len(usage.Samplings)), maximum
+}
@@ -0,0 +1,107 @@
+package datasource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/datasource"
+)
+
+func TestDataSourceWebdockServerMetrics(t *testing.T) {
+	ctx := context.Background()
+	client := &mocks.ClientInterface{}
+	mockErr := errors.New("mock error")
+
+	tests := map[string]struct {
+		includeHistory bool
+		diags          diag.Diagnostics
+		wantCPU        []float64
+		wantMemory     []float64
+		mock           func()
+	}{
+		"success": {
+			wantCPU:    []float64{12.5, 12.5, 12.5},
+			wantMemory: []float64{512, 512, 512},
+			mock: func() {
+				client.On("GetServerMetricsNow", ctx, "server1").Once().Return(&api.ServerMetrics{
+					CPU: api.UsageMetric{
+						Latest: api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 12.5},
+					},
+					Memory: api.UsageMetric{
+						Latest:  api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 512},
+						Allowed: 1024,
+					},
+				}, nil)
+			},
+		},
+		"success: with history": {
+			includeHistory: true,
+			wantCPU:        []float64{10, 30, 80},
+			wantMemory:     []float64{256, 384, 512},
+			mock: func() {
+				client.On("GetServerMetrics", ctx, "server1").Once().Return(&api.ServerMetrics{
+					CPU: api.UsageMetric{
+						Latest: api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 10},
+						Samplings: []api.MetricSampling{
+							{Timestamp: "2022-10-25 04:11:34", Amount: 80},
+							{Timestamp: "2022-10-25 04:41:34", Amount: 0},
+							{Timestamp: "2022-10-25 05:11:34", Amount: 10},
+						},
+					},
+					Memory: api.UsageMetric{
+						Latest: api.MetricSampling{Timestamp: "2022-10-25 05:11:34", Amount: 256},
+						Samplings: []api.MetricSampling{
+							{Timestamp: "2022-10-25 04:11:34", Amount: 512},
+							{Timestamp: "2022-10-25 05:11:34", Amount: 256},
+						},
+					},
+				}, nil)
+			},
+		},
+		"error: ": {
+			mock: func() {
+				client.On("GetServerMetricsNow", ctx, "server1").Once().Return(nil, mockErr)
+			},
+			diags: diag.FromErr(errors.New("mock error")),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := datasource.ServerMetrics().TestResourceData()
+
+			assert.Nil(t, rd.Set("server_slug", "server1"))
+			assert.Nil(t, rd.Set("include_history", test.includeHistory))
+
+			diags := datasource.ServerMetrics().ReadContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if test.diags.HasError() {
+				return
+			}
+
+			assert.Equal(t, test.wantCPU, []float64{
+				rd.Get("cpu_usage").(float64),
+				rd.Get("cpu_usage_average").(float64),
+				rd.Get("cpu_usage_max").(float64),
+			})
+
+			assert.Equal(t, test.wantMemory, []float64{
+				rd.Get("memory_usage").(float64),
+				rd.Get("memory_usage_average").(float64),
+				rd.Get("memory_usage_max").(float64),
+			})
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+func ServerSnapshots() *schema.Resource {
+	datasourceSchema := map[string]*schema.Schema{
+		"server_slug": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"snapshots": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: schemas.Snapshot(),
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: readServerSnapshots,
+		Schema:      datasourceSchema,
+	}
+}
+
+func readServerSnapshots(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	snapshots, err := client.GetServerSnapshots(ctx, d.Get("server_slug").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("snapshots")
+
+	if err = d.Set("snapshots", snapshots); err != nil {
+		return diag.Errorf("error setting snapshots: %s", err)
+	}
+
+	return nil
+}
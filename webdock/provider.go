@@ -2,12 +2,15 @@ package webdock
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/zolamk/terraform-provider-webdock/config"
 	"github.com/zolamk/terraform-provider-webdock/webdock/datasource"
 	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
 )
 
 func Provider() *schema.Provider {
@@ -35,22 +38,74 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeInt,
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_RETRY_LIMIT", 3),
-				Description: "The number of times to retry operations with exponetial backoff.",
+				Description: "The number of times to retry API requests that failed with a rate limit, server or network error, or server creates rejected for creating too many servers, with exponential backoff.",
+			},
+			"requests_per_second": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_REQUESTS_PER_SECOND", 5.0),
+				Description: "The number of API requests sent per second across all resources, `0` disables the limit.",
+			},
+			"request_burst": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_REQUEST_BURST", 10),
+				Description: "The number of API requests that can be sent at once before `requests_per_second` applies.",
+			},
+			"create_requests_per_minute": {
+				Type:        schema.TypeFloat,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_CREATE_REQUESTS_PER_MINUTE", 30.0),
+				Description: "The number of API requests creating a resource, such as a server, snapshot or shell user, sent per minute across all resources, `0` disables the limit. Actions on existing resources are only limited by `requests_per_second`.",
+			},
+			"create_request_burst": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_CREATE_REQUEST_BURST", 10),
+				Description: "The number of API requests creating a resource that can be sent at once before `create_requests_per_minute` applies.",
+			},
+			"poll_delay": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEBDOCK_POLL_DELAY", 10),
+				Description: "The number of seconds to wait after starting an action before its event is polled for the first time, `min_backoff` sets the time between the following polls.",
+			},
+			"min_backoff": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WEBDOCK_MIN_BACKOFF", 3),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The minimum number of seconds between polls of an action's event and between readiness checks of a new server, the time between polls backs off exponentially up to 10 seconds.",
+			},
+			"address_family": {
+				Type:         schema.TypeString,
+				Required:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WEBDOCK_ADDRESS_FAMILY", schemas.AddressFamilyIPv4),
+				ValidateFunc: validation.StringInSlice(schemas.AddressFamilies, false),
+				Description:  "The address family (`ipv4`, `ipv6` or `dual`) servers are checked for reachability over and their SSH connection info uses, unless the server sets its own.",
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"webdock_servers":     datasource.Servers(),
-			"webdock_images":      datasource.Images(),
-			"webdock_profiles":    datasource.Profiles(),
-			"webdock_locations":   datasource.Locations(),
-			"webdock_public_keys": datasource.PublicKeys(),
-			"webdock_shell_users": datasource.ShellUsers(),
+			"webdock_servers":          datasource.Servers(),
+			"webdock_images":           datasource.Images(),
+			"webdock_profiles":         datasource.Profiles(),
+			"webdock_locations":        datasource.Locations(),
+			"webdock_public_keys":      datasource.PublicKeys(),
+			"webdock_shell_users":      datasource.ShellUsers(),
+			"webdock_server_snapshots": datasource.ServerSnapshots(),
+			"webdock_account":          datasource.Account(),
+			"webdock_events":           datasource.Events(),
+			"webdock_server_metrics":   datasource.ServerMetrics(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"webdock_server":     resource.Server(),
-			"webdock_public_key": resource.PublicKey(),
-			"webdock_shell_user": resource.ShellUser(),
+			"webdock_server":          resource.Server(),
+			"webdock_public_key":      resource.PublicKey(),
+			"webdock_shell_user":      resource.ShellUser(),
+			"webdock_server_snapshot": resource.ServerSnapshot(),
+			"webdock_script":          resource.Script(),
+			"webdock_server_script":   resource.ServerScript(),
+			"webdock_hook":            resource.Hook(),
 		},
 	}
 
@@ -69,11 +124,18 @@ func Provider() *schema.Provider {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	config := config.Config{
-		Token:            d.Get("token").(string),
-		APIEndpoint:      d.Get("api_endpoint").(string),
-		ServerUpPort:     d.Get("server_up_port").(int),
-		TerraformVersion: terraformVersion,
-		RetryLimit:       d.Get("retry_limit").(int),
+		Token:                   d.Get("token").(string),
+		APIEndpoint:             d.Get("api_endpoint").(string),
+		ServerUpPort:            d.Get("server_up_port").(int),
+		TerraformVersion:        terraformVersion,
+		RetryLimit:              d.Get("retry_limit").(int),
+		RequestsPerSecond:       d.Get("requests_per_second").(float64),
+		RequestBurst:            d.Get("request_burst").(int),
+		CreateRequestsPerMinute: d.Get("create_requests_per_minute").(float64),
+		CreateRequestBurst:      d.Get("create_request_burst").(int),
+		PollDelay:               time.Duration(d.Get("poll_delay").(int)) * time.Second,
+		MinBackoff:              time.Duration(d.Get("min_backoff").(int)) * time.Second,
+		AddressFamily:           d.Get("address_family").(string),
 	}
 
 	return config.Client()
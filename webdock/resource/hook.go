@@ -0,0 +1,120 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+func Hook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createHook,
+		ReadContext:   readHook,
+		DeleteContext: deleteHook,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema:        schemas.Hook(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
+	}
+}
+
+func createHook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	body := api.CreateHookRequestBody{
+		HookType:   api.HookTypeWebhook,
+		HookValue:  d.Get("url").(string),
+		CallbackID: d.Get("callback_id").(string),
+		EventType:  d.Get("event_type").(string),
+	}
+
+	hook, err := client.CreateHook(ctx, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setHookAttributes(d, hook); err != nil {
+		return diag.Errorf("error setting hook: %v", err)
+	}
+
+	return nil
+}
+
+func readHook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	hooks, err := client.GetHooks(ctx)
+	if err != nil {
+		return diag.Errorf("error getting hooks: %v", err)
+	}
+
+	hook := findHookByID(d.Id(), hooks)
+
+	if hook == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setHookAttributes(d, hook); err != nil {
+		return diag.Errorf("error setting hook: %v", err)
+	}
+
+	return nil
+}
+
+func deleteHook(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting hook id to int64: %v", err)
+	}
+
+	if err = client.DeleteHook(ctx, id); err != nil && !errors.Is(err, api.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func findHookByID(id string, hooks api.Hooks) *api.Hook {
+	for _, hook := range hooks {
+		if hook.ID.String() == id {
+			return &hook
+		}
+	}
+
+	return nil
+}
+
+func setHookAttributes(d *schema.ResourceData, hook *api.Hook) error {
+	d.SetId(hook.ID.String())
+
+	if err := d.Set("url", hook.CallbackURL); err != nil {
+		return err
+	}
+
+	if err := d.Set("event_type", hook.Filter(api.HookFilterEventType)); err != nil {
+		return err
+	}
+
+	if err := d.Set("callback_id", hook.Filter(api.HookFilterCallbackID)); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+)
+
+func TestResourceWebdockHookSchema(t *testing.T) {
+	tests := map[string]struct {
+		raw      map[string]interface{}
+		wantErrs bool
+	}{
+		"when event type is unknown": {
+			raw: map[string]interface{}{
+				"url":        "https://example.com/hook",
+				"event_type": "unknown",
+			},
+			wantErrs: true,
+		},
+		"when url is invalid": {
+			raw: map[string]interface{}{
+				"url": "example.com",
+			},
+			wantErrs: true,
+		},
+		"success": {
+			raw: map[string]interface{}{
+				"url":        "https://example.com/hook",
+				"event_type": "provision",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := resource.Hook().Validate(terraform.NewResourceConfigRaw(test.raw))
+
+			assert.Equal(t, test.wantErrs, diags.HasError())
+		})
+	}
+}
+
+func TestResourceWebdockHookCreate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd            *schema.ResourceData
+		diags         diag.Diagnostics
+		wantID        string
+		wantEventType string
+		mock          func()
+	}{
+		"when create hook fails": {
+			rd: schema.TestResourceDataRaw(t, resource.Hook().Schema, map[string]interface{}{
+				"url": "https://example.com/failing",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateHook", ctx, api.CreateHookRequestBody{
+					HookType:  api.HookTypeWebhook,
+					HookValue: "https://example.com/failing",
+				}).Once().Return(nil, mockErr)
+			},
+		},
+		"success": {
+			rd: schema.TestResourceDataRaw(t, resource.Hook().Schema, map[string]interface{}{
+				"url":        "https://example.com/hook",
+				"event_type": "provision",
+			}),
+			wantID:        "1",
+			wantEventType: "provision",
+			mock: func() {
+				client.On("CreateHook", ctx, api.CreateHookRequestBody{
+					HookType:  api.HookTypeWebhook,
+					HookValue: "https://example.com/hook",
+					EventType: "provision",
+				}).Once().Return(&api.Hook{
+					ID:          json.Number("1"),
+					CallbackURL: "https://example.com/hook",
+					Filters: []api.HookFilter{
+						{
+							Type:  api.HookFilterEventType,
+							Value: "provision",
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Hook().CreateContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+
+			assert.Equal(t, test.wantEventType, test.rd.Get("event_type"))
+		})
+	}
+}
+
+func TestResourceWebdockHookRead(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd             *schema.ResourceData
+		diags          diag.Diagnostics
+		wantID         string
+		wantCallbackID string
+		mock           func()
+	}{
+		"when get hooks fails": {
+			rd: resource.Hook().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags:  diag.Errorf("error getting hooks: %v", mockErr),
+			wantID: "1",
+			mock: func() {
+				client.On("GetHooks", ctx).Once().Return(nil, mockErr)
+			},
+		},
+		"when hook is not found": {
+			rd: resource.Hook().Data(&terraform.InstanceState{
+				ID: "3",
+			}),
+			mock: func() {
+				client.On("GetHooks", ctx).Once().Return(api.Hooks{
+					{
+						ID: json.Number("1"),
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			rd: resource.Hook().Data(&terraform.InstanceState{
+				ID: "2",
+			}),
+			wantID:         "2",
+			wantCallbackID: "callback",
+			mock: func() {
+				client.On("GetHooks", ctx).Once().Return(api.Hooks{
+					{
+						ID:          json.Number("2"),
+						CallbackURL: "https://example.com/hook",
+						Filters: []api.HookFilter{
+							{
+								Type:  api.HookFilterCallbackID,
+								Value: "callback",
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Hook().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+
+			assert.Equal(t, test.wantCallbackID, test.rd.Get("callback_id"))
+		})
+	}
+}
+
+func TestResourceWebdockHookDelete(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when converting hook id to int64 fails": {
+			rd:    resource.Hook().Data(&terraform.InstanceState{}),
+			diags: diag.Errorf("error converting hook id to int64: strconv.ParseInt: parsing \"\": invalid syntax"),
+			mock:  func() {},
+		},
+		"when delete hook fails": {
+			rd: resource.Hook().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("DeleteHook", ctx, int64(1)).Once().Return(mockErr)
+			},
+		},
+		"success": {
+			rd: resource.Hook().Data(&terraform.InstanceState{
+				ID: "2",
+			}),
+			mock: func() {
+				client.On("DeleteHook", ctx, int64(2)).Once().Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Hook().DeleteContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
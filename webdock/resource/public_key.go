@@ -2,7 +2,9 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,8 +18,15 @@ func PublicKey() *schema.Resource {
 		CreateContext: createPublicKey,
 		ReadContext:   readPublicKey,
 		DeleteContext: deletePublicKey,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		SchemaVersion: 0,
 		Schema:        schemas.PublicKey(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
 	}
 }
 
@@ -52,7 +61,8 @@ func readPublicKey(ctx context.Context, d *schema.ResourceData, meta interface{}
 	publicKey := findPublicKeyById(d.Id(), publicKeys)
 
 	if publicKey == nil {
-		return diag.Errorf("error getting public key: not found")
+		d.SetId("")
+		return nil
 	}
 
 	if err = setPublicKeyAttributes(d, publicKey); err != nil {
@@ -71,7 +81,7 @@ func deletePublicKey(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error converting public key id to int64: %v", err)
 	}
 
-	if err = client.DeletePublicKey(ctx, id); err != nil {
+	if err = client.DeletePublicKey(ctx, id); err != nil && !errors.Is(err, api.ErrNotFound) {
 		return diag.FromErr(err)
 	}
 
@@ -110,9 +110,10 @@ func TestResourceWebdockPublicKeyRead(t *testing.T) {
 	client := mocks.NewClientInterface(t)
 	mockErr := errors.New("mock error")
 	tests := map[string]struct {
-		rd    *schema.ResourceData
-		diags diag.Diagnostics
-		mock  func()
+		rd     *schema.ResourceData
+		diags  diag.Diagnostics
+		wantID string
+		mock   func()
 	}{
 		"when get public keys fails": {
 			rd:    resource.PublicKey().Data(&terraform.InstanceState{}),
@@ -125,7 +126,6 @@ func TestResourceWebdockPublicKeyRead(t *testing.T) {
 			rd: resource.PublicKey().Data(&terraform.InstanceState{
 				ID: "3",
 			}),
-			diags: diag.Errorf("error getting public key: not found"),
 			mock: func() {
 				client.On("GetPublicKeys", ctx).Once().Return(api.PublicKeys{
 					{
@@ -147,7 +147,6 @@ func TestResourceWebdockPublicKeyRead(t *testing.T) {
 			rd: resource.PublicKey().Data(&terraform.InstanceState{
 				ID: "3",
 			}),
-			diags: diag.Errorf("error getting public key: not found"),
 			mock: func() {
 				client.On("GetPublicKeys", ctx).Once().Return(nil, nil)
 			},
@@ -156,6 +155,7 @@ func TestResourceWebdockPublicKeyRead(t *testing.T) {
 			rd: resource.PublicKey().Data(&terraform.InstanceState{
 				ID: "2",
 			}),
+			wantID: "2",
 			mock: func() {
 				client.On("GetPublicKeys", ctx).Once().Return(api.PublicKeys{
 					{
@@ -184,6 +184,8 @@ func TestResourceWebdockPublicKeyRead(t *testing.T) {
 			}, client))
 
 			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
 		})
 	}
 }
@@ -0,0 +1,140 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+)
+
+func Script() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createScript,
+		ReadContext:   readScript,
+		UpdateContext: updateScript,
+		DeleteContext: deleteScript,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		SchemaVersion: 0,
+		Schema:        schemas.Script(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Update: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
+	}
+}
+
+func createScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	script, err := client.CreateScript(ctx, scriptRequestBody(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setScriptAttributes(d, script); err != nil {
+		return diag.Errorf("error setting script: %v", err)
+	}
+
+	return nil
+}
+
+func readScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting script id to int64: %v", err)
+	}
+
+	script, err := client.GetScript(ctx, id)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error getting script: %v", err)
+	}
+
+	if err = setScriptAttributes(d, script); err != nil {
+		return diag.Errorf("error setting script: %v", err)
+	}
+
+	return nil
+}
+
+func updateScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting script id to int64: %v", err)
+	}
+
+	script, err := client.UpdateScript(ctx, id, scriptRequestBody(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setScriptAttributes(d, script); err != nil {
+		return diag.Errorf("error setting script: %v", err)
+	}
+
+	return nil
+}
+
+func deleteScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting script id to int64: %v", err)
+	}
+
+	if err = client.DeleteScript(ctx, id); err != nil && !errors.Is(err, api.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func scriptRequestBody(d *schema.ResourceData) api.ScriptRequestBody {
+	return api.ScriptRequestBody{
+		Name:     d.Get("name").(string),
+		Filename: d.Get("filename").(string),
+		Content:  d.Get("content").(string),
+	}
+}
+
+func setScriptAttributes(d *schema.ResourceData, script *api.Script) error {
+	d.SetId(script.ID.String())
+
+	if err := d.Set("name", script.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("filename", script.Filename); err != nil {
+		return err
+	}
+
+	if err := d.Set("content", script.Content); err != nil {
+		return err
+	}
+
+	if err := d.Set("description", script.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
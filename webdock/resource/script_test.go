@@ -0,0 +1,229 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+)
+
+func TestResourceWebdockScriptCreate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd     *schema.ResourceData
+		diags  diag.Diagnostics
+		wantID string
+		mock   func()
+	}{
+		"when create script fails": {
+			rd: schema.TestResourceDataRaw(t, resource.Script().Schema, map[string]interface{}{
+				"name":     "test",
+				"filename": "test.sh",
+				"content":  "echo test",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateScript", ctx, api.ScriptRequestBody{
+					Name:     "test",
+					Filename: "test.sh",
+					Content:  "echo test",
+				}).Once().Return(nil, mockErr)
+			},
+		},
+		"success": {
+			rd: schema.TestResourceDataRaw(t, resource.Script().Schema, map[string]interface{}{
+				"name":     "test",
+				"filename": "test.sh",
+				"content":  "echo test",
+			}),
+			wantID: "1",
+			mock: func() {
+				client.On("CreateScript", ctx, api.ScriptRequestBody{
+					Name:     "test",
+					Filename: "test.sh",
+					Content:  "echo test",
+				}).Once().Return(&api.Script{
+					ID:       json.Number("1"),
+					Name:     "test",
+					Filename: "test.sh",
+					Content:  "echo test",
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Script().CreateContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+		})
+	}
+}
+
+func TestResourceWebdockScriptRead(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd          *schema.ResourceData
+		diags       diag.Diagnostics
+		wantID      string
+		wantContent string
+		mock        func()
+	}{
+		"when get script fails": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags:  diag.Errorf("error getting script: %v", mockErr),
+			wantID: "1",
+			mock: func() {
+				client.On("GetScript", ctx, int64(1)).Once().Return(nil, mockErr)
+			},
+		},
+		"when script is not found": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "2",
+			}),
+			mock: func() {
+				client.On("GetScript", ctx, int64(2)).Once().Return(nil, fmt.Errorf("error getting script: %w", api.APIError{
+					StatusCode: http.StatusNotFound,
+				}))
+			},
+		},
+		"success": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "3",
+			}),
+			wantID:      "3",
+			wantContent: "echo test",
+			mock: func() {
+				client.On("GetScript", ctx, int64(3)).Once().Return(&api.Script{
+					ID:       json.Number("3"),
+					Name:     "test",
+					Filename: "test.sh",
+					Content:  "echo test",
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Script().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+
+			assert.Equal(t, test.wantContent, test.rd.Get("content"))
+		})
+	}
+}
+
+func TestResourceWebdockScriptUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+
+	rd := schema.TestResourceDataRaw(t, resource.Script().Schema, map[string]interface{}{
+		"name":     "test",
+		"filename": "test.sh",
+		"content":  "echo updated",
+	})
+
+	rd.SetId("1")
+
+	client.On("UpdateScript", ctx, int64(1), api.ScriptRequestBody{
+		Name:     "test",
+		Filename: "test.sh",
+		Content:  "echo updated",
+	}).Once().Return(&api.Script{
+		ID:       json.Number("1"),
+		Name:     "test",
+		Filename: "test.sh",
+		Content:  "echo updated",
+	}, nil)
+
+	diags := resource.Script().UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+		ServerUpPort: 2200,
+	}, client))
+
+	assert.Nil(t, diags)
+
+	assert.Equal(t, "echo updated", rd.Get("content"))
+}
+
+func TestResourceWebdockScriptDelete(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when delete script fails": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("DeleteScript", ctx, int64(1)).Once().Return(mockErr)
+			},
+		},
+		"when script is already deleted": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "2",
+			}),
+			mock: func() {
+				client.On("DeleteScript", ctx, int64(2)).Once().Return(api.APIError{
+					StatusCode: http.StatusNotFound,
+				})
+			},
+		},
+		"success": {
+			rd: resource.Script().Data(&terraform.InstanceState{
+				ID: "3",
+			}),
+			mock: func() {
+				client.On("DeleteScript", ctx, int64(3)).Once().Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.Script().DeleteContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
@@ -3,8 +3,9 @@ package resource
 import (
 	"context"
 	"errors"
-	"math"
-	"strings"
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,39 +16,49 @@ import (
 	"github.com/zolamk/terraform-provider-webdock/webdock/utils"
 )
 
-var (
-	tooManyServersMessage = "You are creating too many servers in too short of a timespan. Please wait a while and try again a bit later."
-)
+// defaultReadinessTimeout is how long servers without a readiness_check block are checked for
+const defaultReadinessTimeout = 10 * time.Minute
 
 func Server() *schema.Resource {
+	serverSchema := schemas.Server()
+	serverSchema["snapshot_id"] = schemas.SnapshotID()
+	serverSchema["readiness_check"] = schemas.ReadinessCheck()
+	serverSchema["address_family"] = schemas.AddressFamily()
+	// a server always keeps its main domain, so an empty list could never be applied
+	serverSchema["aliases"].MinItems = 1
+	serverSchema["reinstall_policy"] = schemas.ReinstallPolicy()
+	serverSchema["reinstall_snapshot_id"] = schemas.ReinstallSnapshotID()
+	serverSchema["deletion_protection"] = schemas.DeletionProtection()
+	serverSchema["final_snapshot"] = schemas.FinalSnapshot()
+	serverSchema["power_state"] = schemas.PowerState()
+	serverSchema["reboot_trigger"] = schemas.RebootTrigger()
+
+	for key, attribute := range schemas.ServerResize() {
+		serverSchema[key] = attribute
+	}
+
 	return &schema.Resource{
 		CreateContext: createServer,
 		ReadContext:   readServer,
 		UpdateContext: updateServer,
 		DeleteContext: deleteServer,
+		CustomizeDiff: customizeServerDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		SchemaVersion: 0,
-		Schema:        schemas.Server(),
+		Schema:        serverSchema,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(time.Hour * 3),
+			Update: schema.DefaultTimeout(time.Hour),
+			Delete: schema.DefaultTimeout(time.Minute * 30),
 		},
 	}
 }
 
 func createServer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	currentAttempt := 0
-
-	initialInterval := 1 * time.Minute
-
 	client := meta.(*config.CombinedConfig)
 
-	delay := time.Duration(client.CreatedServersCount.Value()*10) * time.Second
-
-	client.CreatedServersCount.Inc()
-
-	client.Logger.With("delay", delay).Info("sleeping to avoid concurrency issues")
-
-	time.Sleep(delay)
-
 	opts := api.CreateServerRequestBody{
 		Name:           d.Get("name").(string),
 		LocationId:     d.Get("location_id").(string),
@@ -60,33 +71,75 @@ func createServer(ctx context.Context, d *schema.ResourceData, meta interface{})
 		opts.Slug = attr.(string)
 	}
 
-createServer:
+	if attr, ok := d.GetOk("snapshot_id"); ok {
+		opts.SnapshotId = int64(attr.(int))
+	}
+
+	check, readinessTimeout, err := serverReadinessCheck(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	server, err := client.CreateServer(ctx, opts)
 	if err != nil {
-		apiErr := &api.APIError{}
-		if (errors.As(err, apiErr) || errors.As(err, &apiErr)) && apiErr.Message == tooManyServersMessage && currentAttempt < client.RetryLimit {
-			currentAttempt++
+		return diag.FromErr(err)
+	}
+
+	d.SetId(server.Slug)
+
+	err = utils.WaitForServerToBeUP(ctx, client, server.CallbackID, serverAddresses(serverAddressFamily(d, client), server), check, d.Timeout(schema.TimeoutCreate), readinessTimeout)
+	if err != nil {
+		return utils.ActionDiagnostics(err, "server (%s) create event (%s) errored: %v", d.Id(), server.CallbackID, err)
+	}
 
-			delay := initialInterval * time.Duration(math.Pow(2, float64(currentAttempt-1)))
+	if _, ok := d.GetOk("aliases"); ok {
+		if diags := reconcileServerAliases(ctx, d, client, server.Aliases, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+
+		// the create response still has the aliases the server was created with
+		server.Aliases = expandServerAliases(d.Get("aliases").([]interface{}))
+	}
 
-			client.Logger.With("delay", delay).Info("got too many servers error, will retry after a while")
+	if enabled, ok := configuredServerToggle(d, "ssh_password_auth_enabled"); ok && enabled != server.SSHPasswordAuthEnabled {
+		if diags := changeServerSetting(ctx, d, client, "ssh password authentication", enabled, client.SetServerSSHPasswordAuth, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
 
-			time.Sleep(delay)
+		server.SSHPasswordAuthEnabled = enabled
+	}
 
-			goto createServer
+	if enabled, ok := configuredServerToggle(d, "wordpress_lockdown"); ok && enabled != server.WordPressLockDown {
+		if diags := changeServerSetting(ctx, d, client, "wordpress lockdown", enabled, client.SetServerWordPressLockdown, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
 		}
 
-		return diag.FromErr(err)
+		server.WordPressLockDown = enabled
 	}
 
-	d.SetId(server.Slug)
+	if webServer, ok := d.GetOk("webserver"); ok && webServer.(string) != server.WebServer {
+		if diags := changeServerSetting(ctx, d, client, "web server", webServer.(string), client.SetServerWebServer, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
 
-	err = utils.WaitForServerToBeUP(ctx, client, server.CallbackID, server.Ipv4, client.ServerUpPort)
-	if err != nil {
-		return diag.Errorf("server (%s) create event (%s) errored: %v", d.Id(), server.CallbackID, err)
+		server.WebServer = webServer.(string)
 	}
 
-	if err := setServerAttributes(d, server); err != nil {
+	if phpVersion, ok := d.GetOk("php_version"); ok && phpVersion.(string) != server.PhpVersion {
+		if diags := changeServerSetting(ctx, d, client, "php version", phpVersion.(string), client.SetServerPhpVersion, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+
+		server.PhpVersion = phpVersion.(string)
+	}
+
+	if powerState, ok := d.GetOk("power_state"); ok && powerState.(string) != schemas.PowerStateRunning {
+		if diags := reconcileServerPowerState(ctx, d, client, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if err := setServerAttributes(d, server, serverAddressFamily(d, client)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -98,7 +151,7 @@ func readServer(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	server, err := client.GetServerBySlug(context.Background(), d.Id())
 	if err != nil {
-		if errors.Is(err, api.ErrServerNotFound) {
+		if errors.Is(err, api.ErrNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -106,7 +159,7 @@ func readServer(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diag.Errorf("error getting server: %v", err)
 	}
 
-	if err = setServerAttributes(d, server); err != nil {
+	if err = setServerAttributes(d, server, serverAddressFamily(d, client)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -116,6 +169,8 @@ func readServer(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 func updateServer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig)
 
+	var diags diag.Diagnostics
+
 	if d.HasChange("profile_slug") {
 		_, newProfileSlug := d.GetChange("profile_slug")
 
@@ -123,24 +178,41 @@ func updateServer(ctx context.Context, d *schema.ResourceData, meta interface{})
 			ProfileSlug: newProfileSlug.(string),
 		}
 
-		_, err := client.ResizeDryRun(ctx, d.Id(), opts)
+		resize, err := client.ResizeDryRun(ctx, d.Id(), opts)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
+		if err = checkServerResizeCost(d.Get("max_resize_cost").(int), resize); err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, warning := range serverResizeWarnings(resize) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("server (%s) profile change: %s", d.Id(), warning),
+			})
+		}
+
 		callbackID, err := client.ResizeServer(ctx, d.Id(), opts)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		if err = utils.WaitForAction(ctx, client, callbackID); err != nil {
-			return diag.Errorf("server (%s) profile change event (%s) errorred: %s", d.Id(), callbackID, err)
+		if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) profile change event (%s) errorred: %s", d.Id(), callbackID, err)
 		}
 	}
 
 	if d.HasChange("image_slug") {
 		_, newImageSlug := d.GetChange("image_slug")
 
+		if d.Get("reinstall_policy").(string) == schemas.ReinstallPolicySnapshotThenAllow {
+			if diags := snapshotServerBeforeReinstall(ctx, d, client, newImageSlug.(string), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+				return diags
+			}
+		}
+
 		opts := api.ReinstallServerRequestBody{
 			ImageSlug: newImageSlug.(string),
 		}
@@ -150,8 +222,8 @@ func updateServer(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.FromErr(err)
 		}
 
-		if err = utils.WaitForAction(ctx, client, callbackID); err != nil {
-			return diag.Errorf("server (%s) reinstall event (%s) errorred: %s", d.Id(), callbackID, err)
+		if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) reinstall event (%s) errorred: %s", d.Id(), callbackID, err)
 		}
 	}
 
@@ -167,32 +239,614 @@ func updateServer(ctx context.Context, d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return readServer(ctx, d, meta)
+	if d.HasChange("aliases") {
+		// the server's live aliases rather than the old state, so aliases changed outside Terraform are reconciled too
+		server, err := client.GetServerBySlug(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("error getting server: %v", err)
+		}
+
+		if diags := reconcileServerAliases(ctx, d, client, server.Aliases, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("ssh_password_auth_enabled") {
+		enabled := d.Get("ssh_password_auth_enabled").(bool)
+
+		if diags := changeServerSetting(ctx, d, client, "ssh password authentication", enabled, client.SetServerSSHPasswordAuth, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("wordpress_lockdown") {
+		enabled := d.Get("wordpress_lockdown").(bool)
+
+		if diags := changeServerSetting(ctx, d, client, "wordpress lockdown", enabled, client.SetServerWordPressLockdown, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("webserver") {
+		webServer := d.Get("webserver").(string)
+
+		if diags := changeServerSetting(ctx, d, client, "web server", webServer, client.SetServerWebServer, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("php_version") {
+		phpVersion := d.Get("php_version").(string)
+
+		if diags := changeServerSetting(ctx, d, client, "php version", phpVersion, client.SetServerPhpVersion, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("power_state") {
+		if diags := reconcileServerPowerState(ctx, d, client, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	// a server that was just started or is left stopped or suspended doesn't need rebooting
+	if d.HasChange("reboot_trigger") && !d.HasChange("power_state") && d.Get("power_state").(string) == schemas.PowerStateRunning {
+		callbackID, err := client.RebootServer(ctx, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) reboot event (%s) errored: %s", d.Id(), callbackID, err)
+		}
+	}
+
+	return append(diags, readServer(ctx, d, meta)...)
 }
 
 func deleteServer(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig)
 
+	// the state is what was last applied, so protection has to be turned off in an apply before destroying
+	if d.Get("deletion_protection").(bool) {
+		return diag.Errorf("server (%s) has deletion_protection enabled, set it to false and apply before destroying the server", d.Id())
+	}
+
+	var diags diag.Diagnostics
+
+	if d.Get("final_snapshot").(bool) {
+		snapshot, err := client.CreateServerSnapshot(ctx, d.Id(), api.CreateSnapshotRequestBody{
+			Name: fmt.Sprintf("final snapshot of %s", d.Id()),
+		})
+		if err != nil {
+			// there's nothing left to snapshot or delete
+			if errors.Is(err, api.ErrNotFound) {
+				d.SetId("")
+				return nil
+			}
+
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, snapshot.CallbackID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) final snapshot event (%s) errored: %v", d.Id(), snapshot.CallbackID, err)
+		}
+
+		// the snapshot isn't tracked anywhere once the server is gone, so its ID is reported instead
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("server (%s) final snapshot (%s) was taken before destroying the server", d.Id(), snapshot.ID),
+		})
+	}
+
 	callbackID, err := client.DeleteServer(context.Background(), d.Id())
 
 	if err != nil {
-		if strings.Contains(err.Error(), "Not Found") {
-			return nil
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return diags
 		}
 
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	if err = utils.WaitForAction(ctx, client, callbackID); err != nil {
-		diag.Errorf("server (%s) delete event (%s) errorred: %s", d.Id(), callbackID, err)
+	if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return append(diags, utils.ActionDiagnostics(err, "server (%s) delete event (%s) errorred: %s", d.Id(), callbackID, err)...)
 	}
 
 	d.SetId("")
 
+	return diags
+}
+
+// snapshotServerBeforeReinstall takes a snapshot of the server and waits for it to finish so the server's data can be
+// restored if reinstalling it with imageSlug was a mistake
+func snapshotServerBeforeReinstall(ctx context.Context, d *schema.ResourceData, client *config.CombinedConfig, imageSlug string, timeout time.Duration) diag.Diagnostics {
+	snapshot, err := client.CreateServerSnapshot(ctx, d.Id(), api.CreateSnapshotRequestBody{
+		Name: fmt.Sprintf("before reinstall with %s", imageSlug),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := strconv.Atoi(snapshot.ID.String())
+	if err != nil {
+		return diag.Errorf("error converting snapshot id to int: %v", err)
+	}
+
+	// recorded before waiting so the snapshot can still be found when the wait fails
+	if err = d.Set("reinstall_snapshot_id", id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = utils.WaitForAction(ctx, client, snapshot.CallbackID, timeout); err != nil {
+		return utils.ActionDiagnostics(err, "server (%s) snapshot event (%s) errored: %v", d.Id(), snapshot.CallbackID, err)
+	}
+
+	return nil
+}
+
+// reconcileServerPowerState compares the configured power state against the server's current status and triggers the
+// action needed to bring the server into the configured state
+func reconcileServerPowerState(ctx context.Context, d *schema.ResourceData, client *config.CombinedConfig, timeout time.Duration) diag.Diagnostics {
+	server, err := client.GetServerBySlug(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("error getting server: %v", err)
+	}
+
+	switch server.Status {
+	case schemas.PowerStateRunning, schemas.PowerStateStopped, schemas.PowerStateSuspended:
+	default:
+		return diag.Errorf("server (%s) is %s, its power state can only be changed once it's running, stopped or suspended", d.Id(), server.Status)
+	}
+
+	for _, action := range serverPowerActions(client, server.Status, d.Get("power_state").(string)) {
+		callbackID, err := action(ctx, d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, callbackID, timeout); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) power state change event (%s) errored: %s", d.Id(), callbackID, err)
+		}
+	}
+
 	return nil
 }
 
-func setServerAttributes(d *schema.ResourceData, server *api.Server) error {
+// serverPowerActions returns the actions that take a server from its status to powerState in order, a server can only
+// be stopped or suspended while it's running so a stopped or suspended server is started first
+func serverPowerActions(client *config.CombinedConfig, status string, powerState string) []func(ctx context.Context, serverSlug string) (string, error) {
+	if status == powerState {
+		return nil
+	}
+
+	actions := []func(ctx context.Context, serverSlug string) (string, error){}
+
+	if status != schemas.PowerStateRunning {
+		actions = append(actions, client.StartServer)
+	}
+
+	switch powerState {
+	case schemas.PowerStateStopped:
+		actions = append(actions, client.StopServer)
+	case schemas.PowerStateSuspended:
+		actions = append(actions, client.SuspendServer)
+	}
+
+	return actions
+}
+
+// reconcileServerAliases adds the configured aliases the server is missing, makes the first one the main domain and then
+// removes the aliases that aren't configured anymore, in that order since the main domain can't be removed
+func reconcileServerAliases(ctx context.Context, d *schema.ResourceData, client *config.CombinedConfig, current []string, timeout time.Duration) diag.Diagnostics {
+	desired := expandServerAliases(d.Get("aliases").([]interface{}))
+
+	// an empty list is rejected by MinItems, so there's nothing to reconcile only when aliases aren't configured
+	if len(desired) == 0 {
+		return nil
+	}
+
+	for _, alias := range desired {
+		if slices.Contains(current, alias) {
+			continue
+		}
+
+		callbackID, err := client.AddServerAlias(ctx, d.Id(), alias)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, callbackID, timeout); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) alias (%s) add event (%s) errored: %s", d.Id(), alias, callbackID, err)
+		}
+	}
+
+	if len(current) == 0 || current[0] != desired[0] {
+		callbackID, err := client.SetServerMainDomain(ctx, d.Id(), desired[0])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, callbackID, timeout); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) main domain change event (%s) errored: %s", d.Id(), callbackID, err)
+		}
+	}
+
+	for _, alias := range current {
+		if slices.Contains(desired, alias) {
+			continue
+		}
+
+		callbackID, err := client.RemoveServerAlias(ctx, d.Id(), alias)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = utils.WaitForAction(ctx, client, callbackID, timeout); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) alias (%s) remove event (%s) errored: %s", d.Id(), alias, callbackID, err)
+		}
+	}
+
+	return nil
+}
+
+// configuredServerToggle returns the configured value of one of the server's boolean settings and whether it's
+// configured at all, since a false value can't be told apart from an unset one through d.GetOk
+func configuredServerToggle(d *schema.ResourceData, key string) (bool, bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false, false
+	}
+
+	value := rawConfig.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return false, false
+	}
+
+	return value.True(), true
+}
+
+// changeServerSetting changes one of the server's settings in place and waits for the change to finish
+func changeServerSetting[T bool | string](ctx context.Context, d *schema.ResourceData, client *config.CombinedConfig, setting string, value T, set func(ctx context.Context, serverSlug string, value T) (string, error), timeout time.Duration) diag.Diagnostics {
+	callbackID, err := set(ctx, d.Id(), value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = utils.WaitForAction(ctx, client, callbackID, timeout); err != nil {
+		return utils.ActionDiagnostics(err, "server (%s) %s change event (%s) errored: %s", d.Id(), setting, callbackID, err)
+	}
+
+	return nil
+}
+
+func expandServerAliases(aliases []interface{}) []string {
+	expanded := make([]string, 0, len(aliases))
+
+	for _, alias := range aliases {
+		expanded = append(expanded, alias.(string))
+	}
+
+	return expanded
+}
+
+func customizeServerDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// the image or snapshot a server is created from and its readiness check only matter on create
+	if d.Id() == "" {
+		if err := validateReadinessCheck(d); err != nil {
+			return err
+		}
+
+		if err := validateServerStack(ctx, d, meta); err != nil {
+			return err
+		}
+
+		return validateServerSource(ctx, d, meta)
+	}
+
+	if err := validateServerReplacement(d); err != nil {
+		return err
+	}
+
+	if err := validateServerReinstall(d); err != nil {
+		return err
+	}
+
+	if err := validateServerStack(ctx, d, meta); err != nil {
+		return err
+	}
+
+	return previewServerResize(ctx, d, meta)
+}
+
+// validateServerReplacement fails the plan when a change forces a new server while deletion_protection was enabled
+// in the last apply, rather than letting the apply fail after planning a replacement
+func validateServerReplacement(d *schema.ResourceDiff) error {
+	if protected, _ := d.GetChange("deletion_protection"); !protected.(bool) {
+		return nil
+	}
+
+	attributes := Server().Schema
+
+	keys := make([]string, 0, len(attributes))
+
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if attributes[key].ForceNew && d.HasChange(key) {
+			return fmt.Errorf("changing %s replaces server (%s) which has deletion_protection enabled, set it to false and apply before replacing the server", key, d.Id())
+		}
+	}
+
+	return nil
+}
+
+// validateServerReinstall fails the plan when image_slug changes and reinstall_policy doesn't allow reinstalling the
+// server, and marks reinstall_snapshot_id as changing when a snapshot will be taken first
+func validateServerReinstall(d *schema.ResourceDiff) error {
+	if !d.HasChange("image_slug") {
+		return nil
+	}
+
+	switch d.Get("reinstall_policy").(string) {
+	case schemas.ReinstallPolicyAllow:
+		return nil
+	case schemas.ReinstallPolicySnapshotThenAllow:
+		return d.SetNewComputed("reinstall_snapshot_id")
+	default:
+		return fmt.Errorf("changing image_slug reinstalls server (%s) and wipes its data, set reinstall_policy to allow or snapshot_then_allow to reinstall it", d.Id())
+	}
+}
+
+// validateServerStack makes sure an image in the catalog offers the configured web server and PHP version, since
+// only those combinations can be switched to
+func validateServerStack(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("webserver", "php_version") {
+		return nil
+	}
+
+	var webServer, phpVersion string
+
+	if d.NewValueKnown("webserver") {
+		webServer = d.Get("webserver").(string)
+	}
+
+	if d.NewValueKnown("php_version") {
+		phpVersion = d.Get("php_version").(string)
+	}
+
+	if webServer == "" && phpVersion == "" {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig)
+
+	images, err := client.GetServersImages(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		if (webServer == "" || image.WebServer == webServer) && (phpVersion == "" || image.PhpVersion == phpVersion) {
+			return nil
+		}
+	}
+
+	switch {
+	case webServer == "":
+		return fmt.Errorf("no image offers php_version %s", phpVersion)
+	case phpVersion == "":
+		return fmt.Errorf("no image offers webserver %s", webServer)
+	default:
+		return fmt.Errorf("no image offers webserver %s with php_version %s", webServer, phpVersion)
+	}
+}
+
+// previewServerResize dry runs a profile change so its charge and warnings show up in the plan, and fails the plan
+// when the charge exceeds max_resize_cost
+func previewServerResize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("profile_slug") || !d.NewValueKnown("profile_slug") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig)
+
+	resize, err := client.ResizeDryRun(ctx, d.Id(), api.ResizeServerRequestBody{
+		ProfileSlug: d.Get("profile_slug").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error previewing server (%s) profile change: %w", d.Id(), err)
+	}
+
+	if err = d.SetNew("resize_warnings", serverResizeWarnings(resize)); err != nil {
+		return err
+	}
+
+	if resize.ChargeSummary != nil {
+		total := resize.ChargeSummary.Total
+
+		if err = d.SetNew("resize_total", total.Total.Amount); err != nil {
+			return err
+		}
+
+		if err = d.SetNew("resize_vat", total.Vat.Amount); err != nil {
+			return err
+		}
+
+		if err = d.SetNew("resize_currency", total.Total.Currency); err != nil {
+			return err
+		}
+
+		if err = d.SetNew("resize_is_refund", resize.ChargeSummary.IsRefund); err != nil {
+			return err
+		}
+	}
+
+	return checkServerResizeCost(d.Get("max_resize_cost").(int), resize)
+}
+
+// checkServerResizeCost errors when a profile change is charged more than maxCost, refunds and a maxCost of 0 are
+// always allowed
+func checkServerResizeCost(maxCost int, resize *api.ServerResize) error {
+	if maxCost <= 0 || resize.ChargeSummary == nil || resize.ChargeSummary.IsRefund {
+		return nil
+	}
+
+	total := resize.ChargeSummary.Total.Total
+
+	if total.Amount > int64(maxCost) {
+		return fmt.Errorf("profile change costs %d %s which exceeds max_resize_cost of %d", total.Amount, total.Currency, maxCost)
+	}
+
+	return nil
+}
+
+func serverResizeWarnings(resize *api.ServerResize) []string {
+	warnings := []string{}
+
+	for _, warning := range resize.Warnings {
+		warnings = append(warnings, warning.Message)
+	}
+
+	return warnings
+}
+
+// validateServerSource makes sure exactly one of image_slug or snapshot_id is configured and, when creating from a
+// snapshot, that the snapshot was taken from a server with the same virtualization type as the one being created.
+func validateServerSource(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	hasImageSlug := !rawConfig.GetAttr("image_slug").IsNull()
+	hasSnapshotID := !rawConfig.GetAttr("snapshot_id").IsNull()
+
+	if hasImageSlug && hasSnapshotID {
+		return errors.New("only one of image_slug or snapshot_id can be set")
+	}
+
+	if !hasImageSlug && !hasSnapshotID {
+		return errors.New("one of image_slug or snapshot_id must be set")
+	}
+
+	if !hasSnapshotID || !d.NewValueKnown("snapshot_id") || !d.NewValueKnown("virtualization") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig)
+
+	snapshotID := strconv.Itoa(d.Get("snapshot_id").(int))
+
+	snapshot, err := findServerSnapshot(ctx, client, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil {
+		return fmt.Errorf("snapshot (%s) not found", snapshotID)
+	}
+
+	virtualization := d.Get("virtualization").(string)
+
+	if snapshot.Virtualization != virtualization {
+		return fmt.Errorf("snapshot (%s) was taken from a %s server and can not be used to create a %s server", snapshotID, snapshot.Virtualization, virtualization)
+	}
+
+	return nil
+}
+
+// validateReadinessCheck makes sure command readiness checks have a command to run
+func validateReadinessCheck(d *schema.ResourceDiff) error {
+	checks := d.Get("readiness_check").([]interface{})
+
+	if len(checks) == 0 || checks[0] == nil {
+		return nil
+	}
+
+	check := checks[0].(map[string]interface{})
+
+	if check["type"].(string) == schemas.ReadinessCheckCommand && check["command"].(string) == "" && d.NewValueKnown("readiness_check.0.command") {
+		return errors.New("readiness_check command must be set for command checks")
+	}
+
+	return nil
+}
+
+// serverReadinessCheck builds the check from the readiness_check block, falling back to a TCP check on the provider's
+// server_up_port
+func serverReadinessCheck(d *schema.ResourceData, client *config.CombinedConfig) (utils.ReadinessCheck, time.Duration, error) {
+	checks := d.Get("readiness_check").([]interface{})
+
+	if len(checks) == 0 || checks[0] == nil {
+		return utils.TCPCheck{Port: client.ServerUpPort}, defaultReadinessTimeout, nil
+	}
+
+	check := checks[0].(map[string]interface{})
+
+	timeout, err := time.ParseDuration(check["timeout"].(string))
+	if err != nil {
+		return nil, 0, fmt.Errorf("error parsing readiness_check timeout: %w", err)
+	}
+
+	port := check["port"].(int)
+
+	switch check["type"].(string) {
+	case schemas.ReadinessCheckSSHBanner:
+		if port == 0 {
+			port = client.ServerUpPort
+		}
+
+		return utils.SSHBannerCheck{Port: port}, timeout, nil
+	case schemas.ReadinessCheckHTTP:
+		scheme := check["scheme"].(string)
+
+		if port == 0 {
+			port = 80
+
+			if scheme == "https" {
+				port = 443
+			}
+		}
+
+		return utils.HTTPCheck{
+			Scheme:         scheme,
+			Port:           port,
+			Path:           check["path"].(string),
+			ExpectedStatus: check["expected_status"].(int),
+			Insecure:       check["insecure"].(bool),
+		}, timeout, nil
+	case schemas.ReadinessCheckCommand:
+		if port == 0 {
+			port = client.ServerUpPort
+		}
+
+		if check["command"].(string) == "" {
+			return nil, 0, errors.New("readiness_check command must be set for command checks")
+		}
+
+		return utils.CommandCheck{
+			Port:       port,
+			User:       check["user"].(string),
+			PrivateKey: check["private_key"].(string),
+			Password:   check["password"].(string),
+			Command:    check["command"].(string),
+		}, timeout, nil
+	default:
+		if port == 0 {
+			port = client.ServerUpPort
+		}
+
+		return utils.TCPCheck{Port: port}, timeout, nil
+	}
+}
+
+func setServerAttributes(d *schema.ResourceData, server *api.Server, addressFamily string) error {
 	if err := d.Set("name", server.Name); err != nil {
 		return err
 	}
@@ -229,22 +883,78 @@ func setServerAttributes(d *schema.ResourceData, server *api.Server) error {
 		return err
 	}
 
+	switch server.Status {
+	case schemas.PowerStateRunning, schemas.PowerStateStopped, schemas.PowerStateSuspended:
+		if err := d.Set("power_state", server.Status); err != nil {
+			return err
+		}
+	}
+
 	if err := d.Set("webserver", server.WebServer); err != nil {
 		return err
 	}
 
+	if err := d.Set("php_version", server.PhpVersion); err != nil {
+		return err
+	}
+
 	if err := d.Set("aliases", server.Aliases); err != nil {
 		return err
 	}
 
+	if err := d.Set("virtualization", server.Virtualization); err != nil {
+		return err
+	}
+
+	if err := d.Set("ssh_password_auth_enabled", server.SSHPasswordAuthEnabled); err != nil {
+		return err
+	}
+
+	if err := d.Set("wordpress_lockdown", server.WordPressLockDown); err != nil {
+		return err
+	}
+
 	if err := d.Set("snapshot_runtime", server.SnapshotRunTime); err != nil {
 		return err
 	}
 
-	d.SetConnInfo(map[string]string{
-		"type": "ssh",
-		"host": server.Ipv4,
-	})
+	if addresses := serverAddresses(addressFamily, server); len(addresses) > 0 {
+		d.SetConnInfo(map[string]string{
+			"type": "ssh",
+			"host": addresses[0],
+		})
+	}
 
 	return nil
 }
+
+// serverAddressFamily returns the server's address_family, falling back to the provider's
+func serverAddressFamily(d *schema.ResourceData, client *config.CombinedConfig) string {
+	if family, ok := d.GetOk("address_family"); ok {
+		return family.(string)
+	}
+
+	return client.AddressFamily
+}
+
+// serverAddresses returns the server's addresses to connect to in order of preference, addresses of the other family
+// are only used when the server has none of the chosen family
+func serverAddresses(addressFamily string, server *api.Server) []string {
+	preferred, fallback := server.Ipv4, server.Ipv6
+
+	if addressFamily == schemas.AddressFamilyIPv6 {
+		preferred, fallback = server.Ipv6, server.Ipv4
+	}
+
+	addresses := []string{}
+
+	if preferred != "" {
+		addresses = append(addresses, preferred)
+	}
+
+	if fallback != "" && (preferred == "" || addressFamily == schemas.AddressFamilyDual) {
+		addresses = append(addresses, fallback)
+	}
+
+	return addresses
+}
@@ -0,0 +1,219 @@
+package resource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+	"github.com/zolamk/terraform-provider-webdock/webdock/utils"
+)
+
+func ServerScript() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createServerScript,
+		ReadContext:   readServerScript,
+		DeleteContext: deleteServerScript,
+		CustomizeDiff: customizeServerScriptDiff,
+		SchemaVersion: 0,
+		Schema:        schemas.ServerScript(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
+	}
+}
+
+func createServerScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	serverSlug := d.Get("server_slug").(string)
+
+	// hashed before deploying so a change made while the script is deployed shows up on the next plan
+	script, err := client.GetScript(ctx, int64(d.Get("script_id").(int)))
+	if err != nil {
+		return diag.Errorf("error getting script: %v", err)
+	}
+
+	if err = d.Set("content_hash", scriptContentHash(script.Content)); err != nil {
+		return diag.Errorf("error setting server script: %v", err)
+	}
+
+	body := api.CreateServerScriptRequestBody{
+		ScriptID:             int64(d.Get("script_id").(int)),
+		Path:                 d.Get("path").(string),
+		MakeScriptExecutable: d.Get("make_executable").(bool),
+		ExecuteImmediately:   d.Get("execute").(bool),
+	}
+
+	serverScript, err := client.CreateServerScript(ctx, serverSlug, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(serverScript.ID.String())
+
+	if serverScript.CallbackID != "" {
+		if err = utils.WaitForAction(ctx, client, serverScript.CallbackID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return utils.ActionDiagnostics(err, "server (%s) script event (%s) errored: %v", serverSlug, serverScript.CallbackID, err)
+		}
+	}
+
+	return readServerScript(ctx, d, meta)
+}
+
+func readServerScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	serverScripts, err := client.GetServerScripts(ctx, d.Get("server_slug").(string))
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error getting server scripts: %v", err)
+	}
+
+	serverScript := findServerScriptByID(d.Id(), serverScripts)
+
+	if serverScript == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setServerScriptAttributes(d, serverScript); err != nil {
+		return diag.Errorf("error setting server script: %v", err)
+	}
+
+	// state from before the hash was tracked gets the current one instead of redeploying every script
+	if d.Get("content_hash").(string) == "" {
+		script, err := client.GetScript(ctx, int64(d.Get("script_id").(int)))
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return diag.Errorf("error getting script: %v", err)
+		}
+
+		if script != nil {
+			if err = d.Set("content_hash", scriptContentHash(script.Content)); err != nil {
+				return diag.Errorf("error setting server script: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func deleteServerScript(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting server script id to int64: %v", err)
+	}
+
+	callbackID, err := client.DeleteServerScript(ctx, d.Get("server_slug").(string), id)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if callbackID != "" {
+		if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return utils.ActionDiagnostics(err, "server script (%s) delete event (%s) errored: %v", d.Id(), callbackID, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// customizeServerScriptDiff redeploys the script once the account script's content no longer matches what was deployed,
+// changes made in the same apply aren't known yet when planning so they're picked up by the next plan or by triggers
+func customizeServerScriptDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("script_id") || !d.NewValueKnown("script_id") {
+		return nil
+	}
+
+	deployed := d.Get("content_hash").(string)
+	if deployed == "" {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig)
+
+	script, err := client.GetScript(ctx, int64(d.Get("script_id").(int)))
+	if err != nil {
+		// a deleted script can't be redeployed, recreating it changes the script id anyway
+		if errors.Is(err, api.ErrNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting script (%d): %w", d.Get("script_id").(int), err)
+	}
+
+	current := scriptContentHash(script.Content)
+	if current == deployed {
+		return nil
+	}
+
+	if err = d.SetNew("content_hash", current); err != nil {
+		return err
+	}
+
+	return d.ForceNew("content_hash")
+}
+
+func scriptContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func findServerScriptByID(id string, serverScripts api.ServerScripts) *api.ServerScript {
+	for _, serverScript := range serverScripts {
+		if serverScript.ID.String() == id {
+			return &serverScript
+		}
+	}
+
+	return nil
+}
+
+func setServerScriptAttributes(d *schema.ResourceData, serverScript *api.ServerScript) error {
+	d.SetId(serverScript.ID.String())
+
+	if err := d.Set("name", serverScript.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("path", serverScript.Path); err != nil {
+		return err
+	}
+
+	if err := d.Set("last_run", serverScript.LastRun); err != nil {
+		return err
+	}
+
+	if err := d.Set("last_run_callback_id", serverScript.LastRunCallbackID); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_at", serverScript.Created); err != nil {
+		return err
+	}
+
+	return nil
+}
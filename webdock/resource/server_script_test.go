@@ -0,0 +1,366 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+)
+
+func TestResourceWebdockServerScriptCreate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when get script fails": {
+			rd: schema.TestResourceDataRaw(t, resource.ServerScript().Schema, map[string]interface{}{
+				"server_slug": "test",
+				"script_id":   4,
+				"path":        "/root/test.sh",
+			}),
+			diags: diag.Errorf("error getting script: %v", mockErr),
+			mock: func() {
+				client.On("GetScript", ctx, int64(4)).Once().Return(nil, mockErr)
+			},
+		},
+		"when create server script fails": {
+			rd: schema.TestResourceDataRaw(t, resource.ServerScript().Schema, map[string]interface{}{
+				"server_slug": "test",
+				"script_id":   1,
+				"path":        "/root/test.sh",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("GetScript", ctx, int64(1)).Once().Return(&api.Script{Content: "echo test"}, nil)
+
+				client.On("CreateServerScript", ctx, "test", api.CreateServerScriptRequestBody{
+					ScriptID:             1,
+					Path:                 "/root/test.sh",
+					MakeScriptExecutable: true,
+					ExecuteImmediately:   true,
+				}).Once().Return(nil, mockErr)
+			},
+		},
+		"when wait for action fails": {
+			rd: schema.TestResourceDataRaw(t, resource.ServerScript().Schema, map[string]interface{}{
+				"server_slug": "test",
+				"script_id":   2,
+				"path":        "/root/test.sh",
+			}),
+			diags: diag.Errorf("server (test) script event (callback) errored: %v", mockErr),
+			mock: func() {
+				client.On("GetScript", ctx, int64(2)).Once().Return(&api.Script{Content: "echo test"}, nil)
+
+				client.On("CreateServerScript", ctx, "test", mock.MatchedBy(func(body api.CreateServerScriptRequestBody) bool {
+					return body.ScriptID == 2
+				})).Once().Return(&api.ServerScript{
+					ID:         json.Number("1"),
+					CallbackID: "callback",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"success": {
+			rd: schema.TestResourceDataRaw(t, resource.ServerScript().Schema, map[string]interface{}{
+				"server_slug":     "test",
+				"script_id":       3,
+				"path":            "/root/test.sh",
+				"make_executable": false,
+				"execute":         false,
+			}),
+			mock: func() {
+				client.On("GetScript", ctx, int64(3)).Once().Return(&api.Script{Content: "echo test"}, nil)
+
+				client.On("CreateServerScript", ctx, "test", api.CreateServerScriptRequestBody{
+					ScriptID: 3,
+					Path:     "/root/test.sh",
+				}).Once().Return(&api.ServerScript{
+					ID: json.Number("1"),
+				}, nil)
+
+				client.On("GetServerScripts", ctx, "test").Once().Return(api.ServerScripts{
+					{
+						ID:      json.Number("1"),
+						Name:    "test",
+						Path:    "/root/test.sh",
+						Created: "2022-12-22T03:54:56+03:00",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerScript().CreateContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if test.diags == nil {
+				assert.Equal(t, "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d", test.rd.Get("content_hash"))
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerScriptRead(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd       *schema.ResourceData
+		diags    diag.Diagnostics
+		wantID   string
+		wantHash string
+		mock     func()
+	}{
+		"when get server scripts fails": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags:  diag.Errorf("error getting server scripts: %v", mockErr),
+			wantID: "1",
+			mock: func() {
+				client.On("GetServerScripts", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when server script is not found": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "3",
+			}),
+			mock: func() {
+				client.On("GetServerScripts", ctx, mock.Anything).Once().Return(api.ServerScripts{
+					{
+						ID: json.Number("1"),
+					},
+				}, nil)
+			},
+		},
+		"when content hash is missing": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "2",
+				Attributes: map[string]string{
+					"script_id": "5",
+				},
+			}),
+			wantID:   "2",
+			wantHash: "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d",
+			mock: func() {
+				client.On("GetServerScripts", ctx, mock.Anything).Once().Return(api.ServerScripts{
+					{
+						ID: json.Number("2"),
+					},
+				}, nil)
+
+				client.On("GetScript", ctx, int64(5)).Once().Return(&api.Script{Content: "echo test"}, nil)
+			},
+		},
+		"success": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"content_hash": "hash",
+				},
+			}),
+			wantID:   "1",
+			wantHash: "hash",
+			mock: func() {
+				client.On("GetServerScripts", ctx, mock.Anything).Once().Return(api.ServerScripts{
+					{
+						ID:                json.Number("1"),
+						Name:              "test",
+						Path:              "/root/test.sh",
+						LastRun:           "2022-12-22T03:54:56+03:00",
+						LastRunCallbackID: "callback",
+						Created:           "2022-12-22T03:54:56+03:00",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerScript().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+
+			if test.wantID != "" {
+				assert.Equal(t, test.wantHash, test.rd.Get("content_hash"))
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerScriptCustomizeDiff(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	meta := config.NewCombinedConfig(&config.Config{
+		ServerUpPort: 2200,
+	}, client)
+	state := func(scriptID, contentHash string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "1",
+			Attributes: map[string]string{
+				"id":              "1",
+				"server_slug":     "test",
+				"script_id":       scriptID,
+				"path":            "/root/test.sh",
+				"make_executable": "true",
+				"execute":         "true",
+				"content_hash":    contentHash,
+			},
+		}
+	}
+	cfg := func(scriptID int) map[string]interface{} {
+		return map[string]interface{}{
+			"server_slug": "test",
+			"script_id":   scriptID,
+			"path":        "/root/test.sh",
+		}
+	}
+	tests := map[string]struct {
+		state       *terraform.InstanceState
+		config      map[string]interface{}
+		wantErr     error
+		wantReplace bool
+		wantHash    string
+		mock        func()
+	}{
+		"when script content is unchanged": {
+			state:  state("1", "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d"),
+			config: cfg(1),
+			mock: func() {
+				client.On("GetScript", ctx, int64(1)).Once().Return(&api.Script{Content: "echo test"}, nil)
+			},
+		},
+		"when script content changed": {
+			state:       state("2", "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d"),
+			config:      cfg(2),
+			wantReplace: true,
+			wantHash:    "4ebeff2e060a062f04a4d18934f27a4458a6ee3d5d1a84eccdae0bde8af684ce",
+			mock: func() {
+				client.On("GetScript", ctx, int64(2)).Once().Return(&api.Script{Content: "echo changed"}, nil)
+			},
+		},
+		"when script was deleted": {
+			state:  state("3", "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d"),
+			config: cfg(3),
+			mock: func() {
+				client.On("GetScript", ctx, int64(3)).Once().Return(nil, api.ErrNotFound)
+			},
+		},
+		"when get script fails": {
+			state:   state("4", "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d"),
+			config:  cfg(4),
+			wantErr: fmt.Errorf("error getting script (4): %w", mockErr),
+			mock: func() {
+				client.On("GetScript", ctx, int64(4)).Once().Return(nil, mockErr)
+			},
+		},
+		"when content hash was never recorded": {
+			state:  state("5", ""),
+			config: cfg(5),
+			mock:   func() {},
+		},
+		"when script id changes": {
+			state:       state("6", "d960c2eba2b5400c91a09fdec42dabef3cfd2c19a92591a5b2e5437a99a5a91d"),
+			config:      cfg(7),
+			wantReplace: true,
+			mock:        func() {},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diff, err := resource.ServerScript().SimpleDiff(ctx, test.state, terraform.NewResourceConfigRaw(test.config), meta)
+
+			assert.Equal(t, test.wantErr, err)
+
+			if test.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, test.wantReplace, diff != nil && diff.RequiresNew())
+
+			if test.wantHash != "" {
+				assert.Equal(t, test.wantHash, diff.Attributes["content_hash"].New)
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerScriptDelete(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when converting server script id to int64 fails": {
+			rd:    resource.ServerScript().Data(&terraform.InstanceState{}),
+			diags: diag.Errorf("error converting server script id to int64: strconv.ParseInt: parsing \"\": invalid syntax"),
+			mock:  func() {},
+		},
+		"when delete server script fails": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("DeleteServerScript", ctx, mock.Anything, int64(1)).Once().Return("", mockErr)
+			},
+		},
+		"success": {
+			rd: resource.ServerScript().Data(&terraform.InstanceState{
+				ID: "2",
+			}),
+			mock: func() {
+				client.On("DeleteServerScript", ctx, mock.Anything, int64(2)).Once().Return("", nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerScript().DeleteContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
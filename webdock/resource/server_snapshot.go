@@ -0,0 +1,169 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/webdock/schemas"
+	"github.com/zolamk/terraform-provider-webdock/webdock/utils"
+)
+
+func ServerSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createServerSnapshot,
+		ReadContext:   readServerSnapshot,
+		DeleteContext: deleteServerSnapshot,
+		SchemaVersion: 0,
+		Schema:        schemas.Snapshot(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
+	}
+}
+
+func createServerSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	serverSlug := d.Get("server_slug").(string)
+
+	body := api.CreateSnapshotRequestBody{
+		Name: d.Get("name").(string),
+	}
+
+	snapshot, err := client.CreateServerSnapshot(ctx, serverSlug, body)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(snapshot.ID.String())
+
+	if err = utils.WaitForAction(ctx, client, snapshot.CallbackID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return utils.ActionDiagnostics(err, "server (%s) snapshot event (%s) errored: %v", serverSlug, snapshot.CallbackID, err)
+	}
+
+	return readServerSnapshot(ctx, d, meta)
+}
+
+func readServerSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	snapshots, err := client.GetServerSnapshots(ctx, d.Get("server_slug").(string))
+	if err != nil {
+		// the server the snapshot was taken from is gone along with its snapshots
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error getting server snapshots: %v", err)
+	}
+
+	snapshot := findSnapshotByID(d.Id(), snapshots)
+
+	if snapshot == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = setSnapshotAttributes(d, snapshot); err != nil {
+		return diag.Errorf("error setting server snapshot: %v", err)
+	}
+
+	return nil
+}
+
+func deleteServerSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("error converting snapshot id to int64: %v", err)
+	}
+
+	callbackID, err := client.DeleteServerSnapshot(ctx, d.Get("server_slug").(string), id)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return utils.ActionDiagnostics(err, "server snapshot (%s) delete event (%s) errored: %v", d.Id(), callbackID, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func findSnapshotByID(id string, snapshots api.Snapshots) *api.Snapshot {
+	for _, snapshot := range snapshots {
+		if snapshot.ID.String() == id {
+			return &snapshot
+		}
+	}
+
+	return nil
+}
+
+// findServerSnapshot looks for a snapshot across all servers on the account since snapshots are only listed per server
+func findServerSnapshot(ctx context.Context, client api.ClientInterface, id string) (*api.Snapshot, error) {
+	servers, err := client.GetServers(ctx, api.GetServersParams{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting servers: %w", err)
+	}
+
+	for _, server := range servers {
+		snapshots, err := client.GetServerSnapshots(ctx, server.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("error getting server (%s) snapshots: %w", server.Slug, err)
+		}
+
+		if snapshot := findSnapshotByID(id, snapshots); snapshot != nil {
+			return snapshot, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func setSnapshotAttributes(d *schema.ResourceData, snapshot *api.Snapshot) error {
+	d.SetId(snapshot.ID.String())
+
+	if err := d.Set("name", snapshot.Name); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_at", snapshot.Date); err != nil {
+		return err
+	}
+
+	if err := d.Set("type", snapshot.Type); err != nil {
+		return err
+	}
+
+	if err := d.Set("virtualization", snapshot.Virtualization); err != nil {
+		return err
+	}
+
+	if err := d.Set("completed", snapshot.Completed); err != nil {
+		return err
+	}
+
+	if err := d.Set("deletable", snapshot.Deletable); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+)
+
+func TestResourceWebdockServerSnapshotCreate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when create server snapshot fails": {
+			rd:    resource.ServerSnapshot().Data(&terraform.InstanceState{}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, mock.Anything, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when wait for action fails": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			diags: diag.Errorf("server (test) snapshot event (callback) errored: %v", mockErr),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", mock.Anything).Once().Return(&api.Snapshot{
+					ID:         json.Number("1"),
+					Name:       "test",
+					CallbackID: "callback",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when snapshot action fails": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			diags: diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "server (test) snapshot event (callback) errored: create-snapshot event (7) error: not enough disk space",
+					Detail:   "Event ID: 7\nAction: Create snapshot test\nMessage: not enough disk space\nStarted: 25/10/2022 05:11:34\nEnded: 25/10/2022 05:13:34",
+				},
+			},
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", mock.Anything).Once().Return(&api.Snapshot{
+					ID:         json.Number("1"),
+					Name:       "test",
+					CallbackID: "callback",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Id:         json.Number("7"),
+						Action:     "Create snapshot test",
+						EventType:  "create-snapshot",
+						Message:    "not enough disk space",
+						StartTime:  "25/10/2022 05:11:34",
+						EndTime:    "25/10/2022 05:13:34",
+						CallbackId: "callback",
+						Status:     "error",
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", mock.Anything).Once().Return(&api.Snapshot{
+					ID:         json.Number("1"),
+					Name:       "test",
+					CallbackID: "callback",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerSnapshots", ctx, "test").Once().Return(api.Snapshots{
+					{
+						ID:             json.Number("1"),
+						Name:           "test",
+						Date:           "2022-12-22T03:54:56+03:00",
+						Type:           "user",
+						Virtualization: "container",
+						Completed:      true,
+						Deletable:      true,
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerSnapshot().CreateContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
+
+func TestResourceWebdockServerSnapshotRead(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd     *schema.ResourceData
+		diags  diag.Diagnostics
+		wantID string
+		mock   func()
+	}{
+		"when get server snapshots fails": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags:  diag.Errorf("error getting server snapshots: %v", mockErr),
+			wantID: "1",
+			mock: func() {
+				client.On("GetServerSnapshots", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when snapshot is not found": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				ID: "3",
+			}),
+			mock: func() {
+				client.On("GetServerSnapshots", ctx, mock.Anything).Once().Return(api.Snapshots{
+					{
+						ID:   json.Number("1"),
+						Name: "test",
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			wantID: "1",
+			mock: func() {
+				client.On("GetServerSnapshots", ctx, mock.Anything).Once().Return(api.Snapshots{
+					{
+						ID:             json.Number("1"),
+						Name:           "test",
+						Date:           "2022-12-22T03:54:56+03:00",
+						Type:           "user",
+						Virtualization: "container",
+						Completed:      true,
+						Deletable:      true,
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerSnapshot().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+		})
+	}
+}
+
+func TestResourceWebdockServerSnapshotDelete(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd    *schema.ResourceData
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when converting snapshot id to int64 fails": {
+			rd:    resource.ServerSnapshot().Data(&terraform.InstanceState{}),
+			diags: diag.Errorf("error converting snapshot id to int64: strconv.ParseInt: parsing \"\": invalid syntax"),
+			mock:  func() {},
+		},
+		"when delete server snapshot fails": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("DeleteServerSnapshot", ctx, mock.Anything, int64(1)).Once().Return("", mockErr)
+			},
+		},
+		"success": {
+			rd: resource.ServerSnapshot().Data(&terraform.InstanceState{
+				ID: "1",
+			}),
+			mock: func() {
+				client.On("DeleteServerSnapshot", ctx, mock.Anything, int64(1)).Once().Return("callback", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ServerSnapshot().DeleteContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
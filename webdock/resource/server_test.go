@@ -2,10 +2,17 @@ package resource_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -26,11 +33,30 @@ func TestResourceWebdockServerCreate(t *testing.T) {
 	require.Nil(t, err)
 	defer l.Close()
 
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+	}))
+	defer healthy.Close()
+
+	healthyPort, err := strconv.Atoi(healthy.URL[strings.LastIndex(healthy.URL, ":")+1:])
+	require.Nil(t, err)
+
 	tests := map[string]struct {
 		rd    *schema.ResourceData
 		diags diag.Diagnostics
 		mock  func()
 	}{
+		"when readiness check command is missing": {
+			rd: schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+				"readiness_check": []interface{}{
+					map[string]interface{}{
+						"type": "command",
+					},
+				},
+			}),
+			diags: diag.Errorf("readiness_check command must be set for command checks"),
+			mock:  func() {},
+		},
 		"when create server fails": {
 			rd:    resource.Server().Data(&terraform.InstanceState{}),
 			diags: diag.FromErr(mockErr),
@@ -38,15 +64,10 @@ func TestResourceWebdockServerCreate(t *testing.T) {
 				client.On("CreateServer", ctx, mock.Anything).Once().Return(nil, mockErr)
 			},
 		},
-		"when create server fails with too many server error": {
+		"when wait for action fails": {
 			rd:    resource.Server().Data(&terraform.InstanceState{}),
-			diags: nil,
+			diags: diag.Errorf("server (test) create event (callback) errored: %v", mockErr),
 			mock: func() {
-				client.On("CreateServer", ctx, mock.Anything).Twice().Return(nil, &api.APIError{
-					ID:      0,
-					Message: "You are creating too many servers in too short of a timespan. Please wait a while and try again a bit later.",
-				})
-
 				client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
 					SSHPasswordAuthEnabled: true,
 					WordPressLockDown:      true,
@@ -66,16 +87,11 @@ func TestResourceWebdockServerCreate(t *testing.T) {
 					CallbackID:             "callback",
 				}, nil)
 
-				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
-					{
-						Status: "finished",
-					},
-				}, nil)
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
 			},
 		},
-		"when wait for action fails": {
-			rd:    resource.Server().Data(&terraform.InstanceState{}),
-			diags: diag.Errorf("server (test) create event (callback) errored: %v", mockErr),
+		"success": {
+			rd: resource.Server().Data(&terraform.InstanceState{}),
 			mock: func() {
 				client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
 					SSHPasswordAuthEnabled: true,
@@ -96,29 +112,82 @@ func TestResourceWebdockServerCreate(t *testing.T) {
 					CallbackID:             "callback",
 				}, nil)
 
-				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
 			},
 		},
-		"success": {
-			rd: resource.Server().Data(&terraform.InstanceState{}),
+		"success with aliases": {
+			rd: schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+				"aliases": []interface{}{"example.com", "aliased.vps.webdock.cloud"},
+			}),
+			mock: func() {
+				client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
+					Aliases:    []string{"aliased.vps.webdock.cloud"},
+					Ipv4:       "127.0.0.1",
+					Name:       "aliased",
+					Slug:       "aliased",
+					Status:     "provisioning",
+					CallbackID: "aliased",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Times(3).Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("AddServerAlias", ctx, "aliased", "example.com").Once().Return("add", nil)
+
+				client.On("SetServerMainDomain", ctx, "aliased", "example.com").Once().Return("main", nil)
+			},
+		},
+		"success with ssh password authentication disabled": {
+			rd: resource.Server().Data(&terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"ssh_password_auth_enabled": cty.False,
+					"wordpress_lockdown":        cty.True,
+				}),
+			}),
 			mock: func() {
 				client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
 					SSHPasswordAuthEnabled: true,
 					WordPressLockDown:      true,
-					Aliases:                []string{"test"},
-					Date:                   "2022-12-22T03:54:56+03:00",
-					Image:                  "test",
 					Ipv4:                   "127.0.0.1",
-					Ipv6:                   "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
-					Location:               "test",
-					Name:                   "test",
-					Profile:                "test",
-					Slug:                   "test",
-					SnapshotRunTime:        0,
+					Name:                   "hardened",
+					Slug:                   "hardened",
 					Status:                 "provisioning",
-					Virtualization:         "containerd",
-					WebServer:              "nginx",
-					CallbackID:             "callback",
+					CallbackID:             "hardened",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("SetServerSSHPasswordAuth", ctx, "hardened", false).Once().Return("ssh", nil)
+			},
+		},
+		"success with http readiness check": {
+			rd: schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+				"readiness_check": []interface{}{
+					map[string]interface{}{
+						"type": "http",
+						"port": healthyPort,
+						"path": "/health",
+					},
+				},
+			}),
+			mock: func() {
+				client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
+					Ipv4:       "127.0.0.1",
+					Name:       "ready",
+					Slug:       "ready",
+					Status:     "provisioning",
+					CallbackID: "ready",
 				}, nil)
 
 				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
@@ -144,14 +213,55 @@ func TestResourceWebdockServerCreate(t *testing.T) {
 	}
 }
 
+func TestResourceWebdockServerCreateIPv6(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+
+	l, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("ipv6 loopback not available: %v", err)
+	}
+
+	defer l.Close()
+
+	rd := schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+		"address_family": "ipv6",
+	})
+
+	client.On("CreateServer", ctx, mock.Anything).Once().Return(&api.Server{
+		Ipv4:       "192.0.2.1",
+		Ipv6:       "::1",
+		Name:       "ipv6",
+		Slug:       "ipv6",
+		Status:     "provisioning",
+		CallbackID: "ipv6",
+	}, nil)
+
+	client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+		{
+			Status: "finished",
+		},
+	}, nil)
+
+	diags := resource.Server().CreateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+		ServerUpPort: l.Addr().(*net.TCPAddr).Port,
+		RetryLimit:   3,
+	}, client))
+
+	assert.Nil(t, diags)
+
+	assert.Equal(t, "::1", rd.ConnInfo()["host"])
+}
+
 func TestResourceWebdockServerRead(t *testing.T) {
 	ctx := context.Background()
 	client := mocks.NewClientInterface(t)
 	mockErr := errors.New("mock error")
 	tests := map[string]struct {
-		rd    *schema.ResourceData
-		diags diag.Diagnostics
-		mock  func()
+		rd       *schema.ResourceData
+		diags    diag.Diagnostics
+		wantHost string
+		mock     func()
 	}{
 		"when get server by slug fails": {
 			rd:    resource.Server().Data(&terraform.InstanceState{}),
@@ -183,6 +293,50 @@ func TestResourceWebdockServerRead(t *testing.T) {
 				}, nil)
 			},
 		},
+		"success with ipv4 address family": {
+			rd: resource.Server().Data(&terraform.InstanceState{
+				ID: "test",
+			}),
+			wantHost: "83.69.106.70",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, mock.Anything).Once().Return(&api.Server{
+					Ipv4:   "83.69.106.70",
+					Ipv6:   "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
+					Slug:   "test",
+					Status: "running",
+				}, nil)
+			},
+		},
+		"success with ipv6 address family": {
+			rd: resource.Server().Data(&terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"address_family": "ipv6",
+				},
+			}),
+			wantHost: "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, mock.Anything).Once().Return(&api.Server{
+					Ipv4:   "83.69.106.70",
+					Ipv6:   "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
+					Slug:   "test",
+					Status: "running",
+				}, nil)
+			},
+		},
+		"when server has no ipv4 address": {
+			rd: resource.Server().Data(&terraform.InstanceState{
+				ID: "test",
+			}),
+			wantHost: "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, mock.Anything).Once().Return(&api.Server{
+					Ipv6:   "8b34:f82b:999a:1ab5:0cad:f252:af94:bf80",
+					Slug:   "test",
+					Status: "running",
+				}, nil)
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -195,6 +349,1331 @@ func TestResourceWebdockServerRead(t *testing.T) {
 			}, client))
 
 			assert.Equal(t, test.diags, diags)
+
+			if test.wantHost != "" {
+				assert.Equal(t, test.wantHost, test.rd.State().Ephemeral.ConnInfo["host"])
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerCustomizeDiff(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rawConfig cty.Value
+		config    map[string]interface{}
+		wantErr   error
+		mock      func()
+	}{
+		"when both image slug and snapshot id are set": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.StringVal("test"),
+				"snapshot_id": cty.NumberIntVal(10),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+				"image_slug":   "test",
+				"snapshot_id":  10,
+			},
+			wantErr: errors.New("only one of image_slug or snapshot_id can be set"),
+			mock:    func() {},
+		},
+		"when neither image slug nor snapshot id are set": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.NullVal(cty.String),
+				"snapshot_id": cty.NullVal(cty.Number),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+			},
+			wantErr: errors.New("one of image_slug or snapshot_id must be set"),
+			mock:    func() {},
+		},
+		"when command readiness check has no command": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.StringVal("test"),
+				"snapshot_id": cty.NullVal(cty.Number),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+				"image_slug":   "test",
+				"readiness_check": []interface{}{
+					map[string]interface{}{
+						"type": "command",
+					},
+				},
+			},
+			wantErr: errors.New("readiness_check command must be set for command checks"),
+			mock:    func() {},
+		},
+		"when getting servers fails": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.NullVal(cty.String),
+				"snapshot_id": cty.NumberIntVal(10),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+				"snapshot_id":  10,
+			},
+			wantErr: fmt.Errorf("error getting servers: %w", mockErr),
+			mock: func() {
+				client.On("GetServers", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when snapshot is not found": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.NullVal(cty.String),
+				"snapshot_id": cty.NumberIntVal(10),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+				"snapshot_id":  10,
+			},
+			wantErr: errors.New("snapshot (10) not found"),
+			mock: func() {
+				client.On("GetServers", ctx, mock.Anything).Once().Return(api.Servers{{Slug: "golden"}}, nil)
+
+				client.On("GetServerSnapshots", ctx, "golden").Once().Return(api.Snapshots{
+					{
+						ID:             json.Number("1"),
+						Virtualization: "container",
+					},
+				}, nil)
+			},
+		},
+		"when snapshot virtualization does not match": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.NullVal(cty.String),
+				"snapshot_id": cty.NumberIntVal(10),
+			}),
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "test",
+				"snapshot_id":  10,
+			},
+			wantErr: errors.New("snapshot (10) was taken from a kvm server and can not be used to create a container server"),
+			mock: func() {
+				client.On("GetServers", ctx, mock.Anything).Once().Return(api.Servers{{Slug: "golden"}}, nil)
+
+				client.On("GetServerSnapshots", ctx, "golden").Once().Return(api.Snapshots{
+					{
+						ID:             json.Number("10"),
+						Virtualization: "kvm",
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"image_slug":  cty.NullVal(cty.String),
+				"snapshot_id": cty.NumberIntVal(10),
+			}),
+			config: map[string]interface{}{
+				"name":           "test",
+				"location_id":    "test",
+				"profile_slug":   "test",
+				"snapshot_id":    10,
+				"virtualization": "kvm",
+			},
+			mock: func() {
+				client.On("GetServers", ctx, mock.Anything).Once().Return(api.Servers{{Slug: "golden"}}, nil)
+
+				client.On("GetServerSnapshots", ctx, "golden").Once().Return(api.Snapshots{
+					{
+						ID:             json.Number("10"),
+						Virtualization: "kvm",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			_, err := resource.Server().SimpleDiff(ctx, &terraform.InstanceState{
+				RawConfig: test.rawConfig,
+			}, terraform.NewResourceConfigRaw(test.config), config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResourceWebdockServerCustomizeDiffResize(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	resize := &api.ServerResize{
+		ChargeSummary: &api.ChargeSummary{
+			Total: api.ChargeSummaryTotal{
+				Total: api.Price{Amount: 1250, Currency: "EUR"},
+				Vat:   api.Price{Amount: 250, Currency: "EUR"},
+			},
+		},
+		Warnings: []api.Warning{
+			{
+				Message: "the server will be restarted",
+				Type:    "restart",
+			},
+		},
+	}
+	tests := map[string]struct {
+		config       map[string]interface{}
+		wantErr      error
+		wantTotal    string
+		wantWarnings string
+		mock         func()
+	}{
+		"when profile slug does not change": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+			},
+			mock: func() {},
+		},
+		"when resize dry run fails": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "large",
+				"image_slug":   "test",
+			},
+			wantErr: fmt.Errorf("error previewing server (test) profile change: %w", mockErr),
+			mock: func() {
+				client.On("ResizeDryRun", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return(nil, mockErr)
+			},
+		},
+		"when resize costs more than max resize cost": {
+			config: map[string]interface{}{
+				"name":            "test",
+				"location_id":     "test",
+				"profile_slug":    "large",
+				"image_slug":      "test",
+				"max_resize_cost": 1000,
+			},
+			wantErr: errors.New("profile change costs 1250 EUR which exceeds max_resize_cost of 1000"),
+			mock: func() {
+				client.On("ResizeDryRun", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return(resize, nil)
+			},
+		},
+		"success": {
+			config: map[string]interface{}{
+				"name":            "test",
+				"location_id":     "test",
+				"profile_slug":    "large",
+				"image_slug":      "test",
+				"max_resize_cost": 2000,
+			},
+			wantTotal:    "1250",
+			wantWarnings: "the server will be restarted",
+			mock: func() {
+				client.On("ResizeDryRun", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return(resize, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diff, err := resource.Server().SimpleDiff(ctx, &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":           "test",
+					"name":         "test",
+					"location_id":  "test",
+					"profile_slug": "small",
+					"image_slug":   "test",
+				},
+			}, terraform.NewResourceConfigRaw(test.config), config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.wantErr, err)
+
+			if test.wantTotal == "" {
+				return
+			}
+
+			assert.Equal(t, test.wantTotal, diff.Attributes["resize_total"].New)
+
+			assert.Equal(t, test.wantWarnings, diff.Attributes["resize_warnings.0"].New)
+		})
+	}
+}
+
+func TestResourceWebdockServerCustomizeDiffStack(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	images := api.ServerImages{
+		{
+			Slug:       "ubuntu-nginx",
+			WebServer:  "nginx",
+			PhpVersion: "8.1",
+		},
+		{
+			Slug:       "ubuntu-apache",
+			WebServer:  "apache",
+			PhpVersion: "7.4",
+		},
+	}
+	tests := map[string]struct {
+		config  map[string]interface{}
+		wantErr error
+		mock    func()
+	}{
+		"when stack does not change": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+			},
+			mock: func() {},
+		},
+		"when getting images fails": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+				"php_version":  "7.4",
+			},
+			wantErr: mockErr,
+			mock: func() {
+				client.On("GetServersImages", ctx).Once().Return(nil, mockErr)
+			},
+		},
+		"when no image offers the php version with the web server": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+				"php_version":  "7.4",
+			},
+			wantErr: errors.New("no image offers webserver nginx with php_version 7.4"),
+			mock: func() {
+				client.On("GetServersImages", ctx).Once().Return(images, nil)
+			},
+		},
+		"success": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+				"webserver":    "apache",
+				"php_version":  "7.4",
+			},
+			mock: func() {
+				client.On("GetServersImages", ctx).Once().Return(images, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			_, err := resource.Server().SimpleDiff(ctx, &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":           "test",
+					"name":         "test",
+					"location_id":  "test",
+					"profile_slug": "small",
+					"image_slug":   "test",
+					"webserver":    "nginx",
+					"php_version":  "8.1",
+				},
+			}, terraform.NewResourceConfigRaw(test.config), config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResourceWebdockServerCustomizeDiffReinstall(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		config       map[string]interface{}
+		wantErr      error
+		wantComputed bool
+	}{
+		"when image slug does not change": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "test",
+			},
+		},
+		"when reinstall is denied": {
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"image_slug":   "tset",
+			},
+			wantErr: errors.New("changing image_slug reinstalls server (test) and wipes its data, set reinstall_policy to allow or snapshot_then_allow to reinstall it"),
+		},
+		"when reinstall is allowed": {
+			config: map[string]interface{}{
+				"name":             "test",
+				"location_id":      "test",
+				"profile_slug":     "small",
+				"image_slug":       "tset",
+				"reinstall_policy": "allow",
+			},
+		},
+		"when reinstall is allowed after a snapshot": {
+			config: map[string]interface{}{
+				"name":             "test",
+				"location_id":      "test",
+				"profile_slug":     "small",
+				"image_slug":       "tset",
+				"reinstall_policy": "snapshot_then_allow",
+			},
+			wantComputed: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resource.Server().SimpleDiff(ctx, &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":               "test",
+					"name":             "test",
+					"location_id":      "test",
+					"profile_slug":     "small",
+					"image_slug":       "test",
+					"reinstall_policy": "deny",
+				},
+			}, terraform.NewResourceConfigRaw(test.config), config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, nil))
+
+			assert.Equal(t, test.wantErr, err)
+
+			if test.wantErr != nil {
+				return
+			}
+
+			_, computed := diff.Attributes["reinstall_snapshot_id"]
+
+			assert.Equal(t, test.wantComputed, computed)
 		})
 	}
 }
+
+func TestResourceWebdockServerCustomizeDiffReplacement(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		protected string
+		config    map[string]interface{}
+		wantErr   error
+	}{
+		"when server is not protected": {
+			protected: "false",
+			config: map[string]interface{}{
+				"name":         "test",
+				"location_id":  "test",
+				"profile_slug": "small",
+				"snapshot_id":  2,
+			},
+		},
+		"when protected server is replaced": {
+			protected: "true",
+			config: map[string]interface{}{
+				"name":                "test",
+				"location_id":         "test",
+				"profile_slug":        "small",
+				"snapshot_id":         2,
+				"deletion_protection": false,
+			},
+			wantErr: errors.New("changing snapshot_id replaces server (test) which has deletion_protection enabled, set it to false and apply before replacing the server"),
+		},
+		"when protected server is not replaced": {
+			protected: "true",
+			config: map[string]interface{}{
+				"name":                "test",
+				"location_id":         "test",
+				"profile_slug":        "small",
+				"snapshot_id":         1,
+				"deletion_protection": false,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := resource.Server().SimpleDiff(ctx, &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":                  "test",
+					"name":                "test",
+					"location_id":         "test",
+					"profile_slug":        "small",
+					"image_slug":          "test",
+					"snapshot_id":         "1",
+					"deletion_protection": test.protected,
+				},
+			}, terraform.NewResourceConfigRaw(test.config), config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, nil))
+
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdateStack(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		raw   map[string]interface{}
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when switching web server fails": {
+			raw: map[string]interface{}{
+				"webserver": "apache",
+			},
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("SetServerWebServer", ctx, "test", "apache").Once().Return("", mockErr)
+			},
+		},
+		"when php version event fails": {
+			raw: map[string]interface{}{
+				"php_version": "7.4",
+			},
+			diags: diag.Errorf("server (test) php version change event (php) errored: %s", mockErr),
+			mock: func() {
+				client.On("SetServerPhpVersion", ctx, "test", "7.4").Once().Return("php", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"success": {
+			raw: map[string]interface{}{
+				"webserver":   "apache",
+				"php_version": "7.4",
+			},
+			mock: func() {
+				client.On("SetServerWebServer", ctx, "test", "apache").Once().Return("webserver", nil)
+
+				client.On("SetServerPhpVersion", ctx, "test", "7.4").Once().Return("php", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:       "test",
+					Status:     "running",
+					WebServer:  "apache",
+					PhpVersion: "7.4",
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			server := resource.Server()
+
+			current := server.TestResourceData()
+
+			require.Nil(t, current.Set("webserver", "nginx"))
+
+			require.Nil(t, current.Set("php_version", "8.1"))
+
+			current.SetId("test")
+
+			state := current.State()
+
+			diff, err := schema.InternalMap(server.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(test.raw), nil, nil, true)
+			require.Nil(t, err)
+
+			rd, err := schema.InternalMap(server.Schema).Data(state, diff)
+			require.Nil(t, err)
+
+			diags := server.UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if !test.diags.HasError() {
+				assert.Equal(t, "apache", rd.Get("webserver"))
+
+				assert.Equal(t, "7.4", rd.Get("php_version"))
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdateReinstall(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	finished := api.Events{
+		{
+			Status: "finished",
+		},
+	}
+	tests := map[string]struct {
+		policy         string
+		diags          diag.Diagnostics
+		wantSnapshotID int
+		mock           func()
+	}{
+		"when reinstall is allowed": {
+			policy: "allow",
+			mock: func() {
+				client.On("ReinstallServer", ctx, "test", api.ReinstallServerRequestBody{ImageSlug: "tset"}).Once().Return("reinstall", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Image: "tset", Status: "running"}, nil)
+			},
+		},
+		"when taking the snapshot fails": {
+			policy: "snapshot_then_allow",
+			diags:  diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "before reinstall with tset"}).Once().Return(nil, mockErr)
+			},
+		},
+		"when snapshot event fails": {
+			policy:         "snapshot_then_allow",
+			diags:          diag.Errorf("server (test) snapshot event (snapshot) errored: %v", mockErr),
+			wantSnapshotID: 42,
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "before reinstall with tset"}).Once().Return(&api.Snapshot{ID: "42", CallbackID: "snapshot"}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when reinstall is allowed after a snapshot": {
+			policy:         "snapshot_then_allow",
+			wantSnapshotID: 42,
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "before reinstall with tset"}).Once().Return(&api.Snapshot{ID: "42", CallbackID: "snapshot"}, nil)
+
+				client.On("ReinstallServer", ctx, "test", api.ReinstallServerRequestBody{ImageSlug: "tset"}).Once().Return("reinstall", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(finished, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Image: "tset", Status: "running"}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			server := resource.Server()
+
+			current := server.TestResourceData()
+
+			require.Nil(t, current.Set("image_slug", "test"))
+
+			current.SetId("test")
+
+			state := current.State()
+
+			diff, err := schema.InternalMap(server.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"image_slug":       "tset",
+				"reinstall_policy": test.policy,
+			}), nil, nil, true)
+			require.Nil(t, err)
+
+			rd, err := schema.InternalMap(server.Schema).Data(state, diff)
+			require.Nil(t, err)
+
+			diags := server.UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantSnapshotID, rd.Get("reinstall_snapshot_id"))
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdateProfile(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	tests := map[string]struct {
+		maxResizeCost int
+		diags         diag.Diagnostics
+		mock          func()
+	}{
+		"when resize costs more than max resize cost": {
+			maxResizeCost: 1000,
+			diags:         diag.FromErr(errors.New("profile change costs 1250 EUR which exceeds max_resize_cost of 1000")),
+			mock: func() {
+				client.On("ResizeDryRun", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return(&api.ServerResize{
+					ChargeSummary: &api.ChargeSummary{
+						Total: api.ChargeSummaryTotal{
+							Total: api.Price{Amount: 1250, Currency: "EUR"},
+						},
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			diags: diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "server (test) profile change: the server will be restarted",
+				},
+			},
+			mock: func() {
+				client.On("ResizeDryRun", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return(&api.ServerResize{
+					Warnings: []api.Warning{
+						{
+							Message: "the server will be restarted",
+						},
+					},
+				}, nil)
+
+				client.On("ResizeServer", ctx, "test", api.ResizeServerRequestBody{ProfileSlug: "large"}).Once().Return("callback", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Profile: "large", Status: "running"}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+				"profile_slug":    "large",
+				"max_resize_cost": test.maxResizeCost,
+			})
+
+			rd.SetId("test")
+
+			diags := resource.Server().UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdateAliases(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	finished := api.Events{
+		{
+			Status: "finished",
+		},
+	}
+	tests := map[string]struct {
+		aliases []interface{}
+		diags   diag.Diagnostics
+		mock    func()
+	}{
+		"when getting server fails": {
+			aliases: []interface{}{"a.example.com"},
+			diags:   diag.Errorf("error getting server: %v", mockErr),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(nil, mockErr)
+			},
+		},
+		"when an alias was added outside terraform": {
+			aliases: []interface{}{"a.example.com"},
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Aliases: []string{"a.example.com", "b.example.com", "d.example.com"},
+				}, nil)
+
+				client.On("RemoveServerAlias", ctx, "test", "b.example.com").Once().Return("remove", nil)
+
+				client.On("RemoveServerAlias", ctx, "test", "d.example.com").Once().Return("remove", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(finished, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Status:  "running",
+					Aliases: []string{"a.example.com"},
+				}, nil)
+			},
+		},
+		"when add server alias fails": {
+			aliases: []interface{}{"c.example.com", "a.example.com"},
+			diags:   diag.FromErr(mockErr),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Aliases: []string{"a.example.com", "b.example.com"},
+				}, nil)
+
+				client.On("AddServerAlias", ctx, "test", "c.example.com").Once().Return("", mockErr)
+			},
+		},
+		"when remove server alias event fails": {
+			aliases: []interface{}{"a.example.com"},
+			diags:   diag.Errorf("server (test) alias (b.example.com) remove event (remove) errored: %s", mockErr),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Aliases: []string{"a.example.com", "b.example.com"},
+				}, nil)
+
+				client.On("RemoveServerAlias", ctx, "test", "b.example.com").Once().Return("remove", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when an alias is removed": {
+			aliases: []interface{}{"a.example.com"},
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Aliases: []string{"a.example.com", "b.example.com"},
+				}, nil)
+
+				client.On("RemoveServerAlias", ctx, "test", "b.example.com").Once().Return("remove", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Status:  "running",
+					Aliases: []string{"a.example.com"},
+				}, nil)
+			},
+		},
+		"when main domain changes": {
+			aliases: []interface{}{"c.example.com", "a.example.com"},
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Aliases: []string{"a.example.com", "b.example.com"},
+				}, nil)
+
+				client.On("AddServerAlias", ctx, "test", "c.example.com").Once().Return("add", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+
+				client.On("SetServerMainDomain", ctx, "test", "c.example.com").Once().Return("main", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+
+				client.On("RemoveServerAlias", ctx, "test", "b.example.com").Once().Return("remove", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:    "test",
+					Status:  "running",
+					Aliases: []string{"c.example.com", "a.example.com"},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			server := resource.Server()
+
+			current := server.TestResourceData()
+
+			require.Nil(t, current.Set("aliases", []interface{}{"a.example.com", "b.example.com"}))
+
+			current.SetId("test")
+
+			state := current.State()
+
+			diff, err := schema.InternalMap(server.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"aliases": test.aliases,
+			}), nil, nil, true)
+			require.Nil(t, err)
+
+			rd, err := schema.InternalMap(server.Schema).Data(state, diff)
+			require.Nil(t, err)
+
+			diags := server.UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if !test.diags.HasError() {
+				assert.Equal(t, test.aliases, rd.Get("aliases"))
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerValidateAliases(t *testing.T) {
+	diags := resource.Server().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":         "test",
+		"location_id":  "test",
+		"profile_slug": "small",
+		"aliases":      []interface{}{},
+	}))
+
+	require.Len(t, diags, 1)
+
+	assert.Equal(t, "Not enough list items", diags[0].Summary)
+}
+
+func TestResourceWebdockServerUpdateToggles(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		raw   map[string]interface{}
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when disabling ssh password authentication fails": {
+			raw: map[string]interface{}{
+				"ssh_password_auth_enabled": false,
+			},
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("SetServerSSHPasswordAuth", ctx, "test", false).Once().Return("", mockErr)
+			},
+		},
+		"when wordpress lockdown event fails": {
+			raw: map[string]interface{}{
+				"wordpress_lockdown": true,
+			},
+			diags: diag.Errorf("server (test) wordpress lockdown change event (lockdown) errored: %s", mockErr),
+			mock: func() {
+				client.On("SetServerWordPressLockdown", ctx, "test", true).Once().Return("lockdown", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"success": {
+			raw: map[string]interface{}{
+				"ssh_password_auth_enabled": false,
+				"wordpress_lockdown":        true,
+			},
+			mock: func() {
+				client.On("SetServerSSHPasswordAuth", ctx, "test", false).Once().Return("ssh", nil)
+
+				client.On("SetServerWordPressLockdown", ctx, "test", true).Once().Return("lockdown", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{
+					Slug:              "test",
+					Status:            "running",
+					WordPressLockDown: true,
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			server := resource.Server()
+
+			current := server.TestResourceData()
+
+			require.Nil(t, current.Set("ssh_password_auth_enabled", true))
+
+			require.Nil(t, current.Set("wordpress_lockdown", false))
+
+			current.SetId("test")
+
+			state := current.State()
+
+			diff, err := schema.InternalMap(server.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(test.raw), nil, nil, true)
+			require.Nil(t, err)
+
+			rd, err := schema.InternalMap(server.Schema).Data(state, diff)
+			require.Nil(t, err)
+
+			diags := server.UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdatePowerState(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		powerState string
+		diags      diag.Diagnostics
+		mock       func()
+	}{
+		"when get server by slug fails": {
+			powerState: "stopped",
+			diags:      diag.Errorf("error getting server: %v", mockErr),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(nil, mockErr)
+			},
+		},
+		"when stop server fails": {
+			powerState: "stopped",
+			diags:      diag.FromErr(mockErr),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "running"}, nil)
+
+				client.On("StopServer", ctx, "test").Once().Return("", mockErr)
+			},
+		},
+		"when server is already in the desired state": {
+			powerState: "suspended",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "suspended"}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "suspended"}, nil)
+			},
+		},
+		"when server is still provisioning": {
+			powerState: "stopped",
+			diags:      diag.Errorf("server (test) is provisioning, its power state can only be changed once it's running, stopped or suspended"),
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "provisioning"}, nil)
+			},
+		},
+		"when suspended server is stopped": {
+			powerState: "stopped",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "suspended"}, nil)
+
+				client.On("StartServer", ctx, "test").Once().Return("start", nil)
+
+				client.On("StopServer", ctx, "test").Once().Return("stop", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Twice().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "stopped"}, nil)
+			},
+		},
+		"success": {
+			powerState: "running",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "stopped"}, nil)
+
+				client.On("StartServer", ctx, "test").Once().Return("callback", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "running"}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := schema.TestResourceDataRaw(t, resource.Server().Schema, map[string]interface{}{
+				"power_state": test.powerState,
+			})
+
+			rd.SetId("test")
+
+			diags := resource.Server().UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
+
+func TestResourceWebdockServerDelete(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	finished := api.Events{
+		{
+			Status: "finished",
+		},
+	}
+	tests := map[string]struct {
+		state map[string]interface{}
+		diags diag.Diagnostics
+		mock  func()
+	}{
+		"when deletion protection is enabled": {
+			state: map[string]interface{}{
+				"deletion_protection": true,
+				"final_snapshot":      true,
+			},
+			diags: diag.Errorf("server (test) has deletion_protection enabled, set it to false and apply before destroying the server"),
+			mock:  func() {},
+		},
+		"when taking the final snapshot fails": {
+			state: map[string]interface{}{
+				"final_snapshot": true,
+			},
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "final snapshot of test"}).Once().Return(nil, mockErr)
+			},
+		},
+		"when final snapshot event fails": {
+			state: map[string]interface{}{
+				"final_snapshot": true,
+			},
+			diags: diag.Errorf("server (test) final snapshot event (snapshot) errored: %v", mockErr),
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "final snapshot of test"}).Once().Return(&api.Snapshot{ID: "42", CallbackID: "snapshot"}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when server is already gone before the final snapshot": {
+			state: map[string]interface{}{
+				"final_snapshot": true,
+			},
+			mock: func() {
+				client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "final snapshot of test"}).Once().Return(nil, api.ErrNotFound)
+			},
+		},
+		"when server is already gone": {
+			state: map[string]interface{}{},
+			mock: func() {
+				client.On("DeleteServer", ctx, "test").Once().Return("", api.ErrNotFound)
+			},
+		},
+		"success": {
+			state: map[string]interface{}{},
+			mock: func() {
+				client.On("DeleteServer", ctx, "test").Once().Return("delete", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(finished, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := resource.Server().TestResourceData()
+
+			for key, value := range test.state {
+				require.Nil(t, rd.Set(key, value))
+			}
+
+			rd.SetId("test")
+
+			diags := resource.Server().DeleteContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			if test.diags.HasError() {
+				assert.Equal(t, "test", rd.Id())
+			} else {
+				assert.Empty(t, rd.Id())
+			}
+		})
+	}
+}
+
+func TestResourceWebdockServerUpdateReboot(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		powerState string
+		diags      diag.Diagnostics
+		mock       func()
+	}{
+		"when reboot fails": {
+			powerState: "running",
+			diags:      diag.FromErr(mockErr),
+			mock: func() {
+				client.On("RebootServer", ctx, "test").Once().Return("", mockErr)
+			},
+		},
+		"when reboot event fails": {
+			powerState: "running",
+			diags:      diag.Errorf("server (test) reboot event (reboot) errored: %s", mockErr),
+			mock: func() {
+				client.On("RebootServer", ctx, "test").Once().Return("reboot", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(nil, mockErr)
+			},
+		},
+		"when server is stopped": {
+			powerState: "stopped",
+			mock: func() {
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "stopped"}, nil)
+			},
+		},
+		"success": {
+			powerState: "running",
+			mock: func() {
+				client.On("RebootServer", ctx, "test").Once().Return("reboot", nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+
+				client.On("GetServerBySlug", ctx, "test").Once().Return(&api.Server{Slug: "test", Status: "running"}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			server := resource.Server()
+
+			current := server.TestResourceData()
+
+			require.Nil(t, current.Set("power_state", test.powerState))
+
+			require.Nil(t, current.Set("reboot_trigger", "1"))
+
+			current.SetId("test")
+
+			state := current.State()
+
+			diff, err := schema.InternalMap(server.Schema).Diff(ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"reboot_trigger": "2",
+			}), nil, nil, true)
+			require.Nil(t, err)
+
+			rd, err := schema.InternalMap(server.Schema).Data(state, diff)
+			require.Nil(t, err)
+
+			diags := server.UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+				RetryLimit:   3,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+		})
+	}
+}
+
+func TestResourceWebdockServerDeleteFinalSnapshot(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	calls := []string{}
+
+	client.On("CreateServerSnapshot", ctx, "test", api.CreateSnapshotRequestBody{Name: "final snapshot of test"}).Once().Run(func(args mock.Arguments) {
+		calls = append(calls, "CreateServerSnapshot")
+	}).Return(&api.Snapshot{ID: "42", CallbackID: "snapshot"}, nil)
+
+	client.On("GetEvents", ctx, api.GetEventsParams{CallbackId: "snapshot"}).Once().Run(func(args mock.Arguments) {
+		calls = append(calls, "GetEvents snapshot")
+	}).Return(api.Events{{Status: "finished"}}, nil)
+
+	client.On("DeleteServer", ctx, "test").Once().Run(func(args mock.Arguments) {
+		calls = append(calls, "DeleteServer")
+	}).Return("delete", nil)
+
+	client.On("GetEvents", ctx, api.GetEventsParams{CallbackId: "delete"}).Once().Run(func(args mock.Arguments) {
+		calls = append(calls, "GetEvents delete")
+	}).Return(api.Events{{Status: "finished"}}, nil)
+
+	rd := resource.Server().TestResourceData()
+
+	require.Nil(t, rd.Set("final_snapshot", true))
+
+	rd.SetId("test")
+
+	diags := resource.Server().DeleteContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+		ServerUpPort: 2200,
+		RetryLimit:   3,
+	}, client))
+
+	assert.Equal(t, diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "server (test) final snapshot (42) was taken before destroying the server",
+		},
+	}, diags)
+
+	assert.Equal(t, []string{"CreateServerSnapshot", "GetEvents snapshot", "DeleteServer", "GetEvents delete"}, calls)
+
+	assert.Empty(t, rd.Id())
+}
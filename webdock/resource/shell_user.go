@@ -2,7 +2,11 @@ package resource
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,39 +18,38 @@ import (
 )
 
 func ShellUser() *schema.Resource {
+	shellUserSchema := schemas.ShellUser()
+	shellUserSchema["password"].ExactlyOneOf = []string{"password", "password_wo"}
+	shellUserSchema["password_wo"] = schemas.ShellUserPasswordWO()
+	shellUserSchema["password_version"] = schemas.ShellUserPasswordVersion()
+
 	return &schema.Resource{
 		CreateContext: createShellUser,
 		UpdateContext: updateShellUser,
 		DeleteContext: deleteShellUser,
 		ReadContext:   readShellUser,
+		Importer: &schema.ResourceImporter{
+			StateContext: importShellUser,
+		},
 		SchemaVersion: 0,
-		Schema:        schemas.ShellUser(),
+		Schema:        shellUserSchema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Minute * 10),
+			Update: schema.DefaultTimeout(time.Minute * 10),
+			Delete: schema.DefaultTimeout(time.Minute * 10),
+		},
 	}
 }
 
 func createShellUser(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig)
 
-	var publicKeys []int
-
-	for _, key := range d.Get("public_keys").([]interface{}) {
-		publicKeys = append(publicKeys, key.(int))
-	}
-
-	delay := time.Duration(client.CreateUsersCount.Value()*10) * time.Second
-
-	client.CreateUsersCount.Inc()
-
-	client.Logger.With("delay", delay).Info("sleeping to avoid concurrency issues")
-
-	time.Sleep(delay)
-
 	createShellUserBody := api.CreateShellUserRequestBody{
 		Username:   d.Get("username").(string),
-		Password:   d.Get("password").(string),
+		Password:   shellUserPassword(d),
 		Group:      d.Get("group").(string),
 		Shell:      d.Get("shell").(string),
-		PublicKeys: publicKeys,
+		PublicKeys: expandPublicKeyIDs(d.Get("public_keys").(*schema.Set)),
 	}
 
 	shellUser, err := client.CreateShellUser(ctx, d.Get("server_slug").(string), createShellUserBody)
@@ -54,8 +57,8 @@ func createShellUser(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.FromErr(err)
 	}
 
-	if err := utils.WaitForAction(ctx, client, shellUser.CallbackID); err != nil {
-		return diag.Errorf("error creating shell user: %s", err)
+	if err := utils.WaitForAction(ctx, client, shellUser.CallbackID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return utils.ActionDiagnostics(err, "error creating shell user: %s", err)
 	}
 
 	if err = setShellUserAttributes(d, shellUser); err != nil {
@@ -70,13 +73,20 @@ func readShellUser(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 	shellUsers, err := client.GetShellUsers(ctx, d.Get("server_slug").(string))
 	if err != nil {
+		// the server the shell user belonged to is gone
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
 		return diag.Errorf("error getting shell users: %v", err)
 	}
 
 	shellUser := findShellUserByID(d.Id(), shellUsers)
 
 	if shellUser == nil {
-		return diag.Errorf("error getting public key: 404 Not Found")
+		d.SetId("")
+		return nil
 	}
 
 	if err = setShellUserAttributes(d, shellUser); err != nil {
@@ -94,17 +104,65 @@ func updateShellUser(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error converting id to number: %v", err)
 	}
 
-	shellUser, err := client.UpdateShellUserPublicKeys(ctx, d.Get("server_slug").(string), id, d.Get("public_keys").([]int))
+	serverSlug := d.Get("server_slug").(string)
+
+	if d.HasChanges("password", "password_version") {
+		password := shellUserPassword(d)
+
+		if password == "" {
+			return diag.Errorf("error updating shell user password: one of password or password_wo must be set")
+		}
+
+		shellUser, err := client.UpdateShellUserPassword(ctx, serverSlug, id, password)
+		if err != nil {
+			return diag.Errorf("error updating shell user password: %v", err)
+		}
+
+		if err := utils.WaitForAction(ctx, client, shellUser.CallbackID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return utils.ActionDiagnostics(err, "error updating shell user password: %v", err)
+		}
+	}
+
+	if !d.HasChange("public_keys") {
+		return nil
+	}
+
+	// diff against the keys the shell user actually has since they may have been changed outside terraform
+	shellUsers, err := client.GetShellUsers(ctx, serverSlug)
 	if err != nil {
-		return diag.Errorf("error updating shell user: %v", err)
+		return diag.Errorf("error getting shell users: %v", err)
+	}
+
+	shellUser := findShellUserByID(d.Id(), shellUsers)
+
+	if shellUser == nil {
+		return diag.Errorf("shell user (%s) not found", d.Id())
 	}
 
-	if err := utils.WaitForAction(ctx, client, shellUser.CallbackID); err != nil {
+	publicKeys, err := flattenPublicKeyIDs(shellUser.PublicKeys)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := d.Get("public_keys").(*schema.Set)
+
+	current := schema.NewSet(desired.F, publicKeys)
+
+	if desired.Difference(current).Len() == 0 && current.Difference(desired).Len() == 0 {
+		return nil
+	}
+
+	shellUser, err = client.UpdateShellUserPublicKeys(ctx, serverSlug, id, expandPublicKeyIDs(desired))
+	if err != nil {
 		return diag.Errorf("error updating shell user: %v", err)
 	}
 
-	if err := d.Set("public_keys", shellUser.PublicKeys); err != nil {
-		return diag.Errorf("error setting public keys: %v", err)
+	if err := utils.WaitForAction(ctx, client, shellUser.CallbackID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return utils.ActionDiagnostics(err, "error updating shell user: %v", err)
+	}
+
+	if err = setShellUserAttributes(d, shellUser); err != nil {
+		return diag.Errorf("error setting shell user: %v", err)
 	}
 
 	return nil
@@ -120,16 +178,57 @@ func deleteShellUser(ctx context.Context, d *schema.ResourceData, meta interface
 
 	callbackID, err := client.DeleteShellUser(ctx, d.Get("server_slug").(string), id)
 	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			d.SetId("")
+			return nil
+		}
+
 		return diag.Errorf("error deleting shell user: %v", err)
 	}
 
-	if err = utils.WaitForAction(ctx, client, callbackID); err != nil {
-		return diag.Errorf("Error deleting shell user (%s): %v", d.Id(), err)
+	if err = utils.WaitForAction(ctx, client, callbackID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return utils.ActionDiagnostics(err, "Error deleting shell user (%s): %v", d.Id(), err)
 	}
 
 	return nil
 }
 
+// importShellUser splits the server_slug/id import ID since shell users can only be looked up through their server
+func importShellUser(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	serverSlug, id, ok := strings.Cut(d.Id(), "/")
+	if !ok || serverSlug == "" || id == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected server_slug/id", d.Id())
+	}
+
+	if err := d.Set("server_slug", serverSlug); err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// shellUserPassword returns password, or password_wo which is never saved to state so it can only be read from the
+// configuration
+func shellUserPassword(d *schema.ResourceData) string {
+	if password := d.Get("password").(string); password != "" {
+		return password
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return ""
+	}
+
+	passwordWO := rawConfig.GetAttr("password_wo")
+	if passwordWO.IsNull() || !passwordWO.IsKnown() {
+		return ""
+	}
+
+	return passwordWO.AsString()
+}
+
 func findShellUserByID(id string, shellUsers api.ShellUsers) *api.ShellUser {
 	if shellUsers == nil {
 		return nil
@@ -163,5 +262,41 @@ func setShellUserAttributes(d *schema.ResourceData, shellUser *api.ShellUser) er
 		return err
 	}
 
+	publicKeys, err := flattenPublicKeyIDs(shellUser.PublicKeys)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("public_keys", publicKeys); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func flattenPublicKeyIDs(publicKeys api.PublicKeys) ([]interface{}, error) {
+	ids := make([]interface{}, 0, len(publicKeys))
+
+	for _, publicKey := range publicKeys {
+		id, err := publicKey.Id.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("error converting public key id to number: %w", err)
+		}
+
+		ids = append(ids, int(id))
+	}
+
+	return ids, nil
+}
+
+func expandPublicKeyIDs(publicKeys *schema.Set) []int {
+	ids := make([]int, 0, publicKeys.Len())
+
+	for _, id := range publicKeys.List() {
+		ids = append(ids, id.(int))
+	}
+
+	sort.Ints(ids)
+
+	return ids
+}
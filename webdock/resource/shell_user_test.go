@@ -0,0 +1,524 @@
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/resource"
+)
+
+func TestResourceWebdockShellUserImport(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	tests := map[string]struct {
+		id             string
+		wantErr        error
+		wantID         string
+		wantServerSlug string
+	}{
+		"when id has no server slug": {
+			id:      "1",
+			wantErr: errors.New("unexpected format of ID (1), expected server_slug/id"),
+		},
+		"when id is empty": {
+			id:      "test/",
+			wantErr: errors.New("unexpected format of ID (test/), expected server_slug/id"),
+		},
+		"success": {
+			id:             "test/1",
+			wantID:         "1",
+			wantServerSlug: "test",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rd := resource.ShellUser().Data(&terraform.InstanceState{
+				ID: test.id,
+			})
+
+			rds, err := resource.ShellUser().Importer.StateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.wantErr, err)
+
+			if test.wantErr != nil {
+				return
+			}
+
+			assert.Len(t, rds, 1)
+
+			assert.Equal(t, test.wantID, rds[0].Id())
+
+			assert.Equal(t, test.wantServerSlug, rds[0].Get("server_slug"))
+		})
+	}
+}
+
+func TestResourceWebdockShellUserCreate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd     *schema.ResourceData
+		diags  diag.Diagnostics
+		wantID string
+		mock   func()
+	}{
+		"when create shell user fails": {
+			rd: schema.TestResourceDataRaw(t, resource.ShellUser().Schema, map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "password",
+				"public_keys": []interface{}{2},
+			}),
+			diags: diag.FromErr(mockErr),
+			mock: func() {
+				client.On("CreateShellUser", ctx, "test", api.CreateShellUserRequestBody{
+					Username:   "test",
+					Password:   "password",
+					Group:      "sudo",
+					Shell:      "/bin/bash",
+					PublicKeys: []int{2},
+				}).Once().Return(nil, mockErr)
+			},
+		},
+		"success with write only password": {
+			rd: resource.ShellUser().Data(&terraform.InstanceState{
+				Attributes: map[string]string{
+					"server_slug":   "test",
+					"username":      "wo",
+					"group":         "sudo",
+					"shell":         "/bin/bash",
+					"public_keys.#": "0",
+				},
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"password_wo": cty.StringVal("write only"),
+				}),
+			}),
+			wantID: "1",
+			mock: func() {
+				client.On("CreateShellUser", ctx, "test", api.CreateShellUserRequestBody{
+					Username:   "wo",
+					Password:   "write only",
+					Group:      "sudo",
+					Shell:      "/bin/bash",
+					PublicKeys: []int{},
+				}).Once().Return(&api.ShellUser{
+					ID:         json.Number("1"),
+					Username:   "wo",
+					CallbackID: "created",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ShellUser().CreateContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+		})
+	}
+}
+
+func TestResourceWebdockShellUserRead(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	tests := map[string]struct {
+		rd             *schema.ResourceData
+		diags          diag.Diagnostics
+		wantID         string
+		wantPublicKeys []interface{}
+		mock           func()
+	}{
+		"when get shell users fails": {
+			rd: resource.ShellUser().Data(&terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			diags:          diag.Errorf("error getting shell users: %v", mockErr),
+			wantID:         "1",
+			wantPublicKeys: []interface{}{},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(nil, mockErr)
+			},
+		},
+		"when server is not found": {
+			rd: resource.ShellUser().Data(&terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			wantPublicKeys: []interface{}{},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(nil, fmt.Errorf("error getting server shell users: %w", api.APIError{
+					StatusCode: http.StatusNotFound,
+				}))
+			},
+		},
+		"when shell user is not found": {
+			rd: resource.ShellUser().Data(&terraform.InstanceState{
+				ID: "4",
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			wantPublicKeys: []interface{}{},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(api.ShellUsers{
+					{
+						ID:       json.Number("1"),
+						Username: "test",
+					},
+				}, nil)
+			},
+		},
+		"success": {
+			rd: resource.ShellUser().Data(&terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"server_slug": "test",
+				},
+			}),
+			wantID:         "1",
+			wantPublicKeys: []interface{}{2, 3},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(api.ShellUsers{
+					{
+						ID:       json.Number("1"),
+						Username: "test",
+						Group:    "sudo",
+						Shell:    "/bin/bash",
+						PublicKeys: api.PublicKeys{
+							{
+								Id:   json.Number("2"),
+								Name: "test",
+							},
+							{
+								Id:   json.Number("3"),
+								Name: "test2",
+							},
+						},
+						Created: "2022-03-20T04:32:12+03:00",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			diags := resource.ShellUser().ReadContext(ctx, test.rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.Equal(t, test.wantID, test.rd.Id())
+
+			assert.Equal(t, test.wantPublicKeys, test.rd.Get("public_keys").(*schema.Set).List())
+		})
+	}
+}
+
+// shellUserUpdateData returns resource data for updating a shell user with the prior attributes to the raw
+// configuration, which is also sent as the raw config so password_wo can be read
+func shellUserUpdateData(t *testing.T, prior map[string]interface{}, raw map[string]interface{}, rawConfig cty.Value) *schema.ResourceData {
+	shellUser := resource.ShellUser()
+
+	current := shellUser.TestResourceData()
+
+	for key, value := range prior {
+		require.Nil(t, current.Set(key, value))
+	}
+
+	current.SetId("1")
+
+	state := current.State()
+
+	diff, err := schema.InternalMap(shellUser.Schema).Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+
+	diff.RawConfig = rawConfig
+
+	rd, err := schema.InternalMap(shellUser.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	return rd
+}
+
+func TestResourceWebdockShellUserUpdate(t *testing.T) {
+	ctx := context.Background()
+	client := mocks.NewClientInterface(t)
+	mockErr := errors.New("mock error")
+	shellUsers := api.ShellUsers{
+		{
+			ID:       json.Number("1"),
+			Username: "test",
+			PublicKeys: api.PublicKeys{
+				{
+					Id: json.Number("2"),
+				},
+				{
+					Id: json.Number("3"),
+				},
+			},
+		},
+	}
+	prior := map[string]interface{}{
+		"server_slug": "test",
+		"username":    "test",
+		"password":    "test",
+		"public_keys": []interface{}{2, 3},
+	}
+	tests := map[string]struct {
+		prior          map[string]interface{}
+		raw            map[string]interface{}
+		rawConfig      cty.Value
+		diags          diag.Diagnostics
+		wantPublicKeys []interface{}
+		mock           func()
+	}{
+		"when get shell users fails": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{3, 4},
+			},
+			diags:          diag.Errorf("error getting shell users: %v", mockErr),
+			wantPublicKeys: []interface{}{3, 4},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(nil, mockErr)
+			},
+		},
+		"when shell user is not found": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{3, 4},
+			},
+			diags:          diag.Errorf("shell user (1) not found"),
+			wantPublicKeys: []interface{}{3, 4},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(api.ShellUsers{}, nil)
+			},
+		},
+		"when public keys were already changed outside terraform": {
+			prior: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{2},
+			},
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{3, 2},
+			},
+			wantPublicKeys: []interface{}{2, 3},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(shellUsers, nil)
+			},
+		},
+		"when update shell user public keys fails": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{3, 4},
+			},
+			diags:          diag.Errorf("error updating shell user: %v", mockErr),
+			wantPublicKeys: []interface{}{3, 4},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(shellUsers, nil)
+
+				client.On("UpdateShellUserPublicKeys", ctx, "test", int64(1), []int{3, 4}).Once().Return(nil, mockErr)
+			},
+		},
+		"when all public keys are removed": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{},
+			},
+			wantPublicKeys: []interface{}{},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(shellUsers, nil)
+
+				client.On("UpdateShellUserPublicKeys", ctx, "test", int64(1), []int{}).Once().Return(&api.ShellUser{
+					ID:         json.Number("1"),
+					Username:   "test",
+					PublicKeys: api.PublicKeys{},
+					CallbackID: "removed",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+		"when public keys change": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "test",
+				"public_keys": []interface{}{3, 4},
+			},
+			wantPublicKeys: []interface{}{3, 4},
+			mock: func() {
+				client.On("GetShellUsers", ctx, "test").Once().Return(shellUsers, nil)
+
+				client.On("UpdateShellUserPublicKeys", ctx, "test", int64(1), []int{3, 4}).Once().Return(&api.ShellUser{
+					ID:       json.Number("1"),
+					Username: "test",
+					PublicKeys: api.PublicKeys{
+						{
+							Id: json.Number("3"),
+						},
+						{
+							Id: json.Number("4"),
+						},
+					},
+					CallbackID: "updated",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+		"when update shell user password fails": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "rotated",
+				"public_keys": []interface{}{2, 3},
+			},
+			diags:          diag.Errorf("error updating shell user password: %v", mockErr),
+			wantPublicKeys: []interface{}{2, 3},
+			mock: func() {
+				client.On("UpdateShellUserPassword", ctx, "test", int64(1), "rotated").Once().Return(nil, mockErr)
+			},
+		},
+		"when password changes": {
+			prior: prior,
+			raw: map[string]interface{}{
+				"server_slug": "test",
+				"username":    "test",
+				"password":    "rotated",
+				"public_keys": []interface{}{2, 3},
+			},
+			wantPublicKeys: []interface{}{2, 3},
+			mock: func() {
+				client.On("UpdateShellUserPassword", ctx, "test", int64(1), "rotated").Once().Return(&api.ShellUser{
+					ID:         json.Number("1"),
+					CallbackID: "password",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+		"when password version changes": {
+			prior: map[string]interface{}{
+				"server_slug":      "test",
+				"username":         "test",
+				"password_version": 1,
+				"public_keys":      []interface{}{2, 3},
+			},
+			raw: map[string]interface{}{
+				"server_slug":      "test",
+				"username":         "test",
+				"password_wo":      "write only",
+				"password_version": 2,
+				"public_keys":      []interface{}{2, 3},
+			},
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password_wo": cty.StringVal("write only"),
+			}),
+			wantPublicKeys: []interface{}{2, 3},
+			mock: func() {
+				client.On("UpdateShellUserPassword", ctx, "test", int64(1), "write only").Once().Return(&api.ShellUser{
+					ID:         json.Number("1"),
+					CallbackID: "password version",
+				}, nil)
+
+				client.On("GetEvents", ctx, mock.Anything).Once().Return(api.Events{
+					{
+						Status: "finished",
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.mock()
+
+			rd := shellUserUpdateData(t, test.prior, test.raw, test.rawConfig)
+
+			diags := resource.ShellUser().UpdateContext(ctx, rd, config.NewCombinedConfig(&config.Config{
+				ServerUpPort: 2200,
+			}, client))
+
+			assert.Equal(t, test.diags, diags)
+
+			assert.ElementsMatch(t, test.wantPublicKeys, rd.Get("public_keys").(*schema.Set).List())
+
+			assert.Empty(t, rd.Get("password_wo"))
+		})
+	}
+}
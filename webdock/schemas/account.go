@@ -0,0 +1,65 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Account() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "User ID",
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User name",
+		},
+		"user_email": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "User email",
+		},
+		"company_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Company name",
+		},
+		"is_team_member": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user is a member of another user's team",
+		},
+		"team_leader": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Team leader of the team the user is a member of",
+		},
+		"account_balance": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Formatted account credit balance",
+		},
+		"account_balance_raw": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Account credit balance",
+		},
+		"account_balance_currency": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Currency of the account credit balance",
+		},
+		"server_limit": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum number of servers the account can have, 0 when the account has no limit",
+		},
+		"server_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of servers the account has",
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Event() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Event log ID",
+		},
+		"action": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Plain text description of the action",
+		},
+		"action_data": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Parseable representation of the action",
+		},
+		"callback_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Callback ID",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Event type",
+		},
+		"message": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Message returned by the action once finished",
+		},
+		"server_slug": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server slug",
+		},
+		"start_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Start time of the event",
+		},
+		"end_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "End time of the event",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Event status",
+		},
+	}
+}
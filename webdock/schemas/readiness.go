@@ -0,0 +1,123 @@
+package schemas
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ReadinessCheckTCP       = "tcp"
+	ReadinessCheckSSHBanner = "ssh_banner"
+	ReadinessCheckHTTP      = "http"
+	ReadinessCheckCommand   = "command"
+)
+
+const (
+	AddressFamilyIPv4 = "ipv4"
+	AddressFamilyIPv6 = "ipv6"
+	AddressFamilyDual = "dual"
+)
+
+// AddressFamilies lists the valid values of the provider's and servers' address_family
+var AddressFamilies = []string{AddressFamilyIPv4, AddressFamilyIPv6, AddressFamilyDual}
+
+func AddressFamily() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(AddressFamilies, false),
+		Description:  "Address family used for readiness checks and SSH connection info (ipv4, ipv6, dual), defaults to the provider's address_family. The other family is used when the server has no address of the chosen one, dual checks both and prefers IPv4",
+	}
+}
+
+func ReadinessCheck() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "How to tell the server is usable once it's created, defaults to a TCP check on the provider's server_up_port",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{ReadinessCheckTCP, ReadinessCheckSSHBanner, ReadinessCheckHTTP, ReadinessCheckCommand}, false),
+					Description:  "Check type (tcp, ssh_banner, http, command)",
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IsPortNumberOrZero,
+					Description:  "Port to check, defaults to 80 or 443 for http checks and to the provider's server_up_port otherwise",
+				},
+				"scheme": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "http",
+					ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+					Description:  "Scheme of http checks (http, https)",
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "/",
+					Description: "Path requested by http checks",
+				},
+				"expected_status": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      200,
+					ValidateFunc: validation.IntBetween(100, 599),
+					Description:  "Status code http checks expect",
+				},
+				"insecure": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Whether https checks skip verifying the server's certificate",
+				},
+				"command": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Command command checks run over SSH, e.g. `cloud-init status --wait`, the server is ready once it exits successfully",
+				},
+				"user": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "root",
+					Description: "User command checks log in as",
+				},
+				"private_key": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Private key in PEM format command checks log in with",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password command checks log in with when private_key isn't set",
+				},
+				"timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "10m",
+					ValidateFunc: validateDuration,
+					Description:  "How long to keep checking after the server is created, e.g. `10m`",
+				},
+			},
+		},
+	}
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid duration: %w", k, err)}
+	}
+
+	return nil, nil
+}
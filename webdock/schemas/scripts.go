@@ -0,0 +1,109 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Script() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Script ID",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Script name",
+		},
+		"filename": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Script file name",
+		},
+		"content": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Script content",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Script description",
+		},
+	}
+}
+
+func ServerScript() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server script ID",
+		},
+		"server_slug": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Slug of the server to deploy the script to",
+		},
+		"script_id": {
+			Type:        schema.TypeInt,
+			Required:    true,
+			ForceNew:    true,
+			Description: "ID of the account script to deploy",
+		},
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path to deploy the script to on the server",
+		},
+		"make_executable": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+			Description: "Whether the deployed script should be made executable",
+		},
+		"execute": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+			Description: "Whether the script should be run right after being deployed",
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary values that redeploy and rerun the script when changed, e.g. a hash of the script content to redeploy it in the same apply that changes it",
+		},
+		"content_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the account script's content when it was deployed, the script is redeployed once the account script's content changes",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server script name",
+		},
+		"last_run": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date/time the script was last run",
+		},
+		"last_run_callback_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Callback ID of the last run",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Server script creation date/time",
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func MetricSampling() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the sample was taken",
+		},
+		"amount": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Sampled amount",
+		},
+	}
+}
+
+func ServerMetrics() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"server_slug": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Server slug",
+		},
+		"include_history": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to fetch the samples of the last day besides the current usage",
+		},
+		"sampled_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the latest CPU sample was taken",
+		},
+		"cpu_usage": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Latest CPU usage in percent",
+		},
+		"cpu_usage_average": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Average CPU usage in percent, the latest usage without history",
+		},
+		"cpu_usage_max": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Maximum CPU usage in percent, the latest usage without history",
+		},
+		"memory_usage": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Latest memory usage in MiB",
+		},
+		"memory_usage_average": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Average memory usage in MiB, the latest usage without history",
+		},
+		"memory_usage_max": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Maximum memory usage in MiB, the latest usage without history",
+		},
+		"memory_allowed": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Memory the server profile allows in MiB",
+		},
+		"disk_usage": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Latest disk usage in MiB",
+		},
+		"disk_allowed": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Disk space the server profile allows in MiB",
+		},
+		"network_total": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Network traffic this month in MiB",
+		},
+		"network_allowed": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Network traffic the server profile allows per month in MiB",
+		},
+		"network_ingress": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Latest ingress traffic in MiB",
+		},
+		"network_egress": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Latest egress traffic in MiB",
+		},
+		"cpu_samplings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: MetricSampling(),
+			},
+			Description: "CPU usage samples of the last day",
+		},
+		"memory_samplings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: MetricSampling(),
+			},
+			Description: "Memory usage samples of the last day",
+		},
+		"disk_samplings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: MetricSampling(),
+			},
+			Description: "Disk usage samples of the last day",
+		},
+		"network_ingress_samplings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: MetricSampling(),
+			},
+			Description: "Ingress traffic samples of the last day",
+		},
+		"network_egress_samplings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: MetricSampling(),
+			},
+			Description: "Egress traffic samples of the last day",
+		},
+	}
+}
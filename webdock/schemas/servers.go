@@ -2,15 +2,138 @@ package schemas
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	PowerStateRunning   = "running"
+	PowerStateStopped   = "stopped"
+	PowerStateSuspended = "suspended"
+)
+
+const (
+	WebServerApache = "apache"
+	WebServerNginx  = "nginx"
+	WebServerNone   = "none"
+)
+
+const (
+	ReinstallPolicyDeny              = "deny"
+	ReinstallPolicyAllow             = "allow"
+	ReinstallPolicySnapshotThenAllow = "snapshot_then_allow"
+)
+
+func ReinstallPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      ReinstallPolicyDeny,
+		ValidateFunc: validation.StringInSlice([]string{ReinstallPolicyDeny, ReinstallPolicyAllow, ReinstallPolicySnapshotThenAllow}, false),
+		Description:  "What to do when image_slug changes, which reinstalls the server and wipes its data. deny fails the plan, allow reinstalls the server and snapshot_then_allow takes a snapshot of the server before reinstalling it, defaults to deny",
+	}
+}
+
+func ReinstallSnapshotID() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the snapshot taken before the server was last reinstalled with reinstall_policy snapshot_then_allow",
+	}
+}
+
+func PowerState() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{PowerStateRunning, PowerStateStopped, PowerStateSuspended}, false),
+		Description:  "Desired power state of the server (running, stopped, suspended)",
+	}
+}
+
+func RebootTrigger() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value, changing it reboots the server unless power_state changes in the same apply or the server isn't running",
+	}
+}
+
+func DeletionProtection() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether destroying or replacing the server fails, it has to be set to false in an apply before the server can be destroyed or replaced",
+	}
+}
+
+func FinalSnapshot() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to take a snapshot of the server and wait for it to finish before destroying the server",
+	}
+}
+
+// ServerResize is the profile change preview attached to servers during plan
+func ServerResize() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"max_resize_cost": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Fail the plan when a profile change would be charged more than this amount, in cents, no limit when 0",
+		},
+		"resize_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total charged or refunded for the last planned profile change, in cents",
+		},
+		"resize_vat": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "VAT included in resize_total, in cents",
+		},
+		"resize_currency": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Currency of resize_total and resize_vat",
+		},
+		"resize_is_refund": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether resize_total is refunded rather than charged",
+		},
+		"resize_warnings": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Warnings returned for the last planned profile change",
+		},
+	}
+}
+
+func SnapshotID() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "ID of the snapshot to create the server from. The snapshot must have been taken from a server with the same virtualization type",
+	}
+}
+
 func Server() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"aliases": {
 			Type:        schema.TypeList,
 			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
 			Computed:    true,
-			Description: "Server description (what's installed here?) as entered by admin in Server Metadata",
+			Description: "Domain names of the server, the first one is its main domain so at least one is required when set. Aliases missing from the list are removed from the server",
 		},
 		"created_at": {
 			Type:        schema.TypeString,
@@ -19,8 +142,9 @@ func Server() map[string]*schema.Schema {
 		},
 		"image_slug": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Server image",
+			Optional:    true,
+			Computed:    true,
+			Description: "Server image. Exactly one of image_slug or snapshot_id must be set when creating a server, changing it reinstalls the server as allowed by reinstall_policy",
 		},
 		"ipv4": {
 			Type:        schema.TypeString,
@@ -64,16 +188,26 @@ func Server() map[string]*schema.Schema {
 		},
 		"wordpress_lockdown": {
 			Type:        schema.TypeBool,
+			Optional:    true,
 			Computed:    true,
 			Description: "Whether WordPress is in lockdown mode",
 		},
 		"webserver": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{WebServerApache, WebServerNginx, WebServerNone}, false),
+			Description:  "Webserver type (apache, nginx, none), changing it switches the web server without reinstalling the server",
+		},
+		"php_version": {
 			Type:        schema.TypeString,
+			Optional:    true,
 			Computed:    true,
-			Description: "Webserver type (apache, nginx, none)",
+			Description: "PHP version, for example `8.1`, changing it switches the PHP version without reinstalling the server",
 		},
 		"ssh_password_auth_enabled": {
 			Type:        schema.TypeBool,
+			Optional:    true,
 			Computed:    true,
 			Description: "Whether SSH password authentication is enabled",
 		},
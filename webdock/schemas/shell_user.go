@@ -24,10 +24,9 @@ func ShellUser() map[string]*schema.Schema {
 		},
 		"password": {
 			Type:        schema.TypeString,
-			Required:    true,
-			ForceNew:    true,
+			Optional:    true,
 			Sensitive:   true,
-			Description: "shell user password",
+			Description: "shell user password, changing it rotates the password in place",
 		},
 		"group": {
 			Type:        schema.TypeString,
@@ -44,7 +43,7 @@ func ShellUser() map[string]*schema.Schema {
 			Default:     "/bin/bash",
 		},
 		"public_keys": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Required:    true,
 			Elem:        &schema.Schema{Type: schema.TypeInt},
 			Description: "shell user public keys",
@@ -56,3 +55,25 @@ func ShellUser() map[string]*schema.Schema {
 		},
 	}
 }
+
+func ShellUserPasswordWO() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "shell user password that is never saved to state, it's only sent when the shell user is created or password_version changes",
+		// the value is read from the configuration when it's needed so it never has to be diffed against state
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return true
+		},
+	}
+}
+
+func ShellUserPasswordVersion() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{"password_wo"},
+		Description:  "change to rotate the shell user password to password_wo",
+	}
+}
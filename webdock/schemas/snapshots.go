@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Snapshot() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Snapshot ID",
+		},
+		"server_slug": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Slug of the server the snapshot belongs to",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Snapshot name",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Snapshot creation date/time",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Snapshot type (daily, weekly, monthly, user)",
+		},
+		"virtualization": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Virtualization type of the server the snapshot was taken from",
+		},
+		"completed": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the snapshot has finished being taken",
+		},
+		"deletable": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the snapshot can be deleted",
+		},
+	}
+}
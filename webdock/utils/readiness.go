@@ -0,0 +1,250 @@
+package utils
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// ReadinessCheck tells whether a server that finished provisioning is actually usable
+type ReadinessCheck interface {
+	// Ready returns nil once the server at host is usable
+	Ready(ctx context.Context, host string) error
+}
+
+// TCPCheck is ready once a TCP connection to Port can be opened
+type TCPCheck struct {
+	Port int
+}
+
+func (c TCPCheck) Ready(ctx context.Context, host string) error {
+	conn, err := dial(ctx, host, c.Port)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// SSHBannerCheck is ready once the SSH server on Port sends its version banner
+type SSHBannerCheck struct {
+	Port int
+}
+
+func (c SSHBannerCheck) Ready(ctx context.Context, host string) error {
+	conn, err := dial(ctx, host, c.Port)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+
+	banner, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("error reading ssh banner: %w", err)
+	}
+
+	if !strings.HasPrefix(banner, "SSH-") {
+		return fmt.Errorf("unexpected ssh banner %q", strings.TrimSpace(banner))
+	}
+
+	return nil
+}
+
+// HTTPCheck is ready once a GET request to Path responds with ExpectedStatus
+type HTTPCheck struct {
+	// http or https
+	Scheme string
+
+	Port int
+
+	Path string
+
+	ExpectedStatus int
+
+	// Skip verifying the certificate, fresh servers usually don't have one for their IP address
+	Insecure bool
+}
+
+func (c HTTPCheck) Ready(ctx context.Context, host string) error {
+	target := fmt.Sprintf("%s://%s%s", c.Scheme, net.JoinHostPort(host, strconv.Itoa(c.Port)), c.Path)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: c.Insecure,
+			},
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != c.ExpectedStatus {
+		return fmt.Errorf("%s responded with status %d instead of %d", target, res.StatusCode, c.ExpectedStatus)
+	}
+
+	return nil
+}
+
+// CommandCheck is ready once Command exits successfully when run over SSH, e.g. `cloud-init status --wait`
+type CommandCheck struct {
+	Port int
+
+	User string
+
+	// Private key in PEM format, used instead of Password when set
+	PrivateKey string
+
+	Password string
+
+	Command string
+}
+
+func (c CommandCheck) Ready(ctx context.Context, host string) error {
+	auth := []ssh.AuthMethod{ssh.Password(c.Password)}
+
+	if c.PrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(c.PrivateKey))
+		if err != nil {
+			return fmt.Errorf("error parsing private key: %w", err)
+		}
+
+		auth = []ssh.AuthMethod{ssh.PublicKeys(signer)}
+	}
+
+	conn, err := dial(ctx, host, c.Port)
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	address := net.JoinHostPort(host, strconv.Itoa(c.Port))
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, address, &ssh.ClientConfig{
+		User: c.User,
+		Auth: auth,
+		// the host key of a server that was just created isn't known yet
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("error connecting over ssh: %w", err)
+	}
+
+	client := ssh.NewClient(sshConn, chans, reqs)
+
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return fmt.Errorf("error opening ssh session: %w", err)
+	}
+
+	defer session.Close()
+
+	done := make(chan error, 1)
+
+	go func() {
+		output, err := session.CombinedOutput(c.Command)
+		if err != nil {
+			err = fmt.Errorf("%q failed: %w: %s", c.Command, err, strings.TrimSpace(string(output)))
+		}
+
+		done <- err
+	}()
+
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func dial(ctx context.Context, host string, port int) (net.Conn, error) {
+	var dialer net.Dialer
+
+	return dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
+}
+
+// readinessAttemptTimeout bounds a single readiness check so a hanging attempt doesn't use up the whole timeout
+const readinessAttemptTimeout = time.Minute
+
+// WaitForReadiness runs the check against each of hosts in order until it passes for one of them or the timeout
+// passes, waiting backoff between attempts
+func WaitForReadiness(ctx context.Context, hosts []string, check ReadinessCheck, backoff time.Duration, timeout time.Duration) error {
+	if len(hosts) == 0 {
+		return errors.New("server has no address to check")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+
+	defer cancel()
+
+	for {
+		err := checkHosts(ctx, hosts, check)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("server (%s) not ready after %s: %w", strings.Join(hosts, ", "), timeout, err)
+			}
+
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// checkHosts returns nil as soon as the check passes for one of hosts, otherwise the errors of every host
+func checkHosts(ctx context.Context, hosts []string, check ReadinessCheck) error {
+	errs := make([]error, 0, len(hosts))
+
+	for _, host := range hosts {
+		attemptCtx, cancelAttempt := context.WithTimeout(ctx, readinessAttemptTimeout)
+
+		err := check.Ready(attemptCtx, host)
+
+		cancelAttempt()
+
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,298 @@
+package utils_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zolamk/terraform-provider-webdock/webdock/utils"
+	"golang.org/x/crypto/ssh"
+)
+
+// listen starts a local listener handing every connection to handle and returns its port
+func listen(t *testing.T, handle func(conn net.Conn)) int {
+	return listenOn(t, "127.0.0.1:0", handle)
+}
+
+// listenIPv6 is listen on the IPv6 loopback address, skipping the test when IPv6 isn't available
+func listenIPv6(t *testing.T, handle func(conn net.Conn)) int {
+	l, err := net.Listen("tcp", "[::1]:0")
+	if err != nil {
+		t.Skipf("ipv6 loopback not available: %v", err)
+	}
+
+	l.Close()
+
+	return listenOn(t, "[::1]:0", handle)
+}
+
+func listenOn(t *testing.T, address string, handle func(conn net.Conn)) int {
+	l, err := net.Listen("tcp", address)
+	require.Nil(t, err)
+
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			go handle(conn)
+		}
+	}()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// closedPort returns a port nothing listens on
+func closedPort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	port := l.Addr().(*net.TCPAddr).Port
+
+	l.Close()
+
+	return port
+}
+
+func serverPort(t *testing.T, server *httptest.Server) int {
+	serverURL, err := url.Parse(server.URL)
+	require.Nil(t, err)
+
+	port, err := strconv.Atoi(serverURL.Port())
+	require.Nil(t, err)
+
+	return port
+}
+
+// listenSSH starts a local SSH server accepting the given password that exits commands named "true" with status 0 and
+// every other command with status 1
+func listenSSH(t *testing.T, password string) int {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.Nil(t, err)
+
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, given []byte) (*ssh.Permissions, error) {
+			if string(given) != password {
+				return nil, errors.New("wrong password")
+			}
+
+			return nil, nil
+		},
+	}
+
+	serverConfig.AddHostKey(signer)
+
+	return listen(t, func(conn net.Conn) {
+		_, chans, reqs, err := ssh.NewServerConn(conn, serverConfig)
+		if err != nil {
+			return
+		}
+
+		go ssh.DiscardRequests(reqs)
+
+		for newChannel := range chans {
+			channel, requests, err := newChannel.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer channel.Close()
+
+				for req := range requests {
+					if req.Type != "exec" {
+						_ = req.Reply(false, nil)
+						continue
+					}
+
+					_ = req.Reply(true, nil)
+
+					command := string(req.Payload[4:])
+
+					status := make([]byte, 4)
+
+					if command != "true" {
+						binary.BigEndian.PutUint32(status, 1)
+					}
+
+					_, _ = channel.SendRequest("exit-status", false, status)
+
+					return
+				}
+			}()
+		}
+	})
+}
+
+func TestReadinessChecks(t *testing.T) {
+	ctx := context.Background()
+
+	sshPort := listen(t, func(conn net.Conn) {
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte("SSH-2.0-OpenSSH_8.9\r\n"))
+	})
+
+	notSSHPort := listen(t, func(conn net.Conn) {
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte("220 smtp ready\r\n"))
+	})
+
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+	}))
+
+	defer ok.Close()
+
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer unavailable.Close()
+
+	secure := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	defer secure.Close()
+
+	commandPort := listenSSH(t, "secret")
+
+	tests := map[string]struct {
+		check   utils.ReadinessCheck
+		wantErr bool
+	}{
+
+		"when tcp port is open": {
+			check: utils.TCPCheck{Port: sshPort},
+		},
+		"when tcp port is closed": {
+			check:   utils.TCPCheck{Port: closedPort(t)},
+			wantErr: true,
+		},
+		"when ssh banner is sent": {
+			check: utils.SSHBannerCheck{Port: sshPort},
+		},
+		"when ssh banner is not sent": {
+			check:   utils.SSHBannerCheck{Port: notSSHPort},
+			wantErr: true,
+		},
+		"when http status matches": {
+			check: utils.HTTPCheck{Scheme: "http", Port: serverPort(t, ok), Path: "/health", ExpectedStatus: http.StatusOK},
+		},
+		"when http status does not match": {
+			check:   utils.HTTPCheck{Scheme: "http", Port: serverPort(t, unavailable), Path: "/", ExpectedStatus: http.StatusOK},
+			wantErr: true,
+		},
+		"when https certificate is not verified": {
+			check: utils.HTTPCheck{Scheme: "https", Port: serverPort(t, secure), Path: "/", ExpectedStatus: http.StatusOK, Insecure: true},
+		},
+		"when https certificate is verified": {
+			check:   utils.HTTPCheck{Scheme: "https", Port: serverPort(t, secure), Path: "/", ExpectedStatus: http.StatusOK},
+			wantErr: true,
+		},
+		"when command succeeds": {
+			check: utils.CommandCheck{Port: commandPort, User: "root", Password: "secret", Command: "true"},
+		},
+		"when command fails": {
+			check:   utils.CommandCheck{Port: commandPort, User: "root", Password: "secret", Command: "false"},
+			wantErr: true,
+		},
+		"when ssh login fails": {
+			check:   utils.CommandCheck{Port: commandPort, User: "root", Password: "wrong", Command: "true"},
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+
+			defer cancel()
+
+			err := test.check.Ready(ctx, "127.0.0.1")
+
+			assert.Equal(t, test.wantErr, err != nil, err)
+		})
+	}
+}
+
+type countingCheck struct {
+	failures int
+	calls    int
+}
+
+func (c *countingCheck) Ready(ctx context.Context, host string) error {
+	c.calls++
+
+	if c.calls <= c.failures {
+		return errors.New("not ready")
+	}
+
+	return nil
+}
+
+func TestWaitForReadiness(t *testing.T) {
+	backoff := time.Millisecond
+
+	t.Run("when check passes after failing", func(t *testing.T) {
+		check := &countingCheck{failures: 2}
+
+		err := utils.WaitForReadiness(context.Background(), []string{"127.0.0.1"}, check, backoff, time.Second)
+
+		assert.Nil(t, err)
+
+		assert.Equal(t, 3, check.calls)
+	})
+
+	t.Run("when check never passes", func(t *testing.T) {
+		check := &countingCheck{failures: 1 << 30}
+
+		err := utils.WaitForReadiness(context.Background(), []string{"127.0.0.1"}, check, backoff, 20*time.Millisecond)
+
+		assert.EqualError(t, err, "server (127.0.0.1) not ready after 20ms: not ready")
+	})
+
+	t.Run("when server has no address", func(t *testing.T) {
+		err := utils.WaitForReadiness(context.Background(), []string{}, &countingCheck{}, backoff, time.Second)
+
+		assert.EqualError(t, err, "server has no address to check")
+	})
+
+	t.Run("when only the ipv6 address is reachable", func(t *testing.T) {
+		port := listenIPv6(t, func(conn net.Conn) {
+			defer conn.Close()
+
+			_, _ = conn.Write([]byte("SSH-2.0-OpenSSH_8.9\r\n"))
+		})
+
+		err := utils.WaitForReadiness(context.Background(), []string{"127.0.0.1", "::1"}, utils.SSHBannerCheck{Port: port}, backoff, time.Second)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("when neither address is reachable", func(t *testing.T) {
+		check := &countingCheck{failures: 1 << 30}
+
+		err := utils.WaitForReadiness(context.Background(), []string{"127.0.0.1", "::1"}, check, backoff, 20*time.Millisecond)
+
+		assert.EqualError(t, err, "server (127.0.0.1, ::1) not ready after 20ms: not ready\nnot ready")
+	})
+}
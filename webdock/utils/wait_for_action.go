@@ -3,19 +3,20 @@ package utils
 import (
 	"context"
 	"errors"
-	"fmt"
-	"net"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
 )
 
-func WaitForAction(ctx context.Context, client api.ClientInterface, callbackID string) error {
+// WaitForAction polls the event of the action with the given callback ID until it finishes or the timeout passes
+func WaitForAction(ctx context.Context, client *config.CombinedConfig, callbackID string, timeout time.Duration) error {
 	var (
-		pending   = "waiting"
-		working   = "working"
-		target    = "finished"
+		pending   = api.EventStatusWaiting
+		working   = api.EventStatusWorking
+		target    = api.EventStatusFinished
 		refreshfn = func() (result interface{}, state string, err error) {
 			opts := api.GetEventsParams{
 				CallbackId: callbackID,
@@ -32,6 +33,10 @@ func WaitForAction(ctx context.Context, client api.ClientInterface, callbackID s
 
 			event := (events)[0]
 
+			if event.Status == api.EventStatusError {
+				return event, event.Status, api.ActionError{Event: event}
+			}
+
 			return event, event.Status, nil
 		}
 	)
@@ -39,56 +44,34 @@ func WaitForAction(ctx context.Context, client api.ClientInterface, callbackID s
 		Pending:    []string{pending, working},
 		Refresh:    refreshfn,
 		Target:     []string{target},
-		Delay:      10 * time.Second,
-		Timeout:    10 * time.Minute,
-		MinTimeout: 3 * time.Second,
+		Delay:      client.PollDelay,
+		Timeout:    timeout,
+		MinTimeout: client.MinBackoff,
 	}).WaitForStateContext(ctx)
 
 	return err
 }
 
-// WaitForServerToBeUp makes sure besides of getting finished status that the server is actually reachable on port 22
-func WaitForServerToBeUP(ctx context.Context, client api.ClientInterface, callbackID string, ip string, port int) error {
-	var (
-		pending   = "waiting"
-		working   = "working"
-		target    = "finished"
-		refreshfn = func() (result interface{}, state string, err error) {
-			opts := api.GetEventsParams{
-				CallbackId: callbackID,
-			}
-
-			events, err := client.GetEvents(ctx, opts)
-			if err != nil {
-				return nil, "", err
-			}
-
-			if len(events) == 0 {
-				return nil, "", errors.New("error getting event state: response body empty")
-			}
+// WaitForServerToBeUP waits for the server's create event to finish and then for the readiness check to pass against
+// one of the server's addresses, each within their own timeout
+func WaitForServerToBeUP(ctx context.Context, client *config.CombinedConfig, callbackID string, hosts []string, check ReadinessCheck, timeout time.Duration, readinessTimeout time.Duration) error {
+	if err := WaitForAction(ctx, client, callbackID, timeout); err != nil {
+		return err
+	}
 
-			event := (events)[0]
+	return WaitForReadiness(ctx, hosts, check, client.MinBackoff, readinessTimeout)
+}
 
-			if event.Status == target {
-				conn, err := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", ip, port), time.Minute)
-				if err != nil {
-					return event, working, nil
-				}
+// ActionDiagnostics reports an error waiting on an action, adding the failed event's details when the action itself
+// failed
+func ActionDiagnostics(err error, format string, a ...interface{}) diag.Diagnostics {
+	diags := diag.Errorf(format, a...)
 
-				defer conn.Close()
-			}
+	var actionErr api.ActionError
 
-			return event, event.Status, nil
-		}
-	)
-	_, err := (&resource.StateChangeConf{
-		Pending:    []string{pending, working},
-		Refresh:    refreshfn,
-		Target:     []string{target},
-		Delay:      10 * time.Second,
-		Timeout:    10 * time.Minute,
-		MinTimeout: 3 * time.Second,
-	}).WaitForStateContext(ctx)
+	if errors.As(err, &actionErr) {
+		diags[0].Detail = actionErr.Detail()
+	}
 
-	return err
+	return diags
 }
@@ -0,0 +1,86 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zolamk/terraform-provider-webdock/api"
+	"github.com/zolamk/terraform-provider-webdock/config"
+	"github.com/zolamk/terraform-provider-webdock/test/mocks"
+	"github.com/zolamk/terraform-provider-webdock/webdock/utils"
+)
+
+func TestWaitForAction(t *testing.T) {
+	working := api.Events{
+		{
+			Status: api.EventStatusWorking,
+		},
+	}
+	finished := api.Events{
+		{
+			Status: api.EventStatusFinished,
+		},
+	}
+
+	t.Run("when action does not finish within the timeout", func(t *testing.T) {
+		client := mocks.NewClientInterface(t)
+
+		client.On("GetEvents", mock.Anything, api.GetEventsParams{CallbackId: "callback"}).Return(working, nil)
+
+		start := time.Now()
+
+		err := utils.WaitForAction(context.Background(), config.NewCombinedConfig(&config.Config{
+			MinBackoff: 10 * time.Millisecond,
+		}, client), "callback", 200*time.Millisecond)
+
+		elapsed := time.Since(start)
+
+		var timeoutErr *resource.TimeoutError
+
+		assert.ErrorAs(t, err, &timeoutErr)
+
+		assert.GreaterOrEqual(t, elapsed, 200*time.Millisecond)
+
+		assert.Less(t, elapsed, time.Second)
+	})
+
+	t.Run("when poll delay is configured", func(t *testing.T) {
+		client := mocks.NewClientInterface(t)
+
+		client.On("GetEvents", mock.Anything, api.GetEventsParams{CallbackId: "callback"}).Once().Return(finished, nil)
+
+		start := time.Now()
+
+		err := utils.WaitForAction(context.Background(), config.NewCombinedConfig(&config.Config{
+			PollDelay:  300 * time.Millisecond,
+			MinBackoff: 10 * time.Millisecond,
+		}, client), "callback", time.Second)
+
+		assert.Nil(t, err)
+
+		assert.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	})
+
+	t.Run("when min backoff is configured", func(t *testing.T) {
+		client := mocks.NewClientInterface(t)
+
+		client.On("GetEvents", mock.Anything, api.GetEventsParams{CallbackId: "callback"}).Twice().Return(working, nil)
+
+		client.On("GetEvents", mock.Anything, api.GetEventsParams{CallbackId: "callback"}).Once().Return(finished, nil)
+
+		start := time.Now()
+
+		err := utils.WaitForAction(context.Background(), config.NewCombinedConfig(&config.Config{
+			MinBackoff: 300 * time.Millisecond,
+		}, client), "callback", 5*time.Second)
+
+		assert.Nil(t, err)
+
+		// two polls of a working event, each followed by at least the minimum backoff
+		assert.GreaterOrEqual(t, time.Since(start), 600*time.Millisecond)
+	})
+}